@@ -7,19 +7,89 @@ import (
 var (
 	getImpl      Get8Impl
 	getDeltaImpl Get8DeltaImpl
+	getInt32Impl Get8Int32Impl
+
+	getUint64Impl      Get8Uint64Impl
+	getUint64DeltaImpl Get8Uint64DeltaImpl
+
+	getUint16Impl      Get8Uint16Impl
+	getUint16DeltaImpl Get8Uint16DeltaImpl
+
+	get0124Impl      Get8Impl
+	getDelta0124Impl Get8DeltaImpl
+
+	ctrlLenImpl func(ctrls []byte) int
 )
 
 type Get8Impl func(in []byte, out []uint32, ctrl uint16)
 type Get8DeltaImpl func(in []byte, out []uint32, ctrl uint16, prev uint32)
+type Get8Int32Impl func(in []byte, out []int32, ctrl uint16)
+type Get8Uint64Impl func(in []byte, out []uint64, ctrl uint16)
+type Get8Uint64DeltaImpl func(in []byte, out []uint64, ctrl uint16, prev uint64)
+type Get8Uint16Impl func(in []byte, out []uint16, ctrl uint8)
+type Get8Uint16DeltaImpl func(in []byte, out []uint16, ctrl uint8, prev uint16)
+
+// tier is the tier of kernels currently in use.
+var tier = detectTier()
 
 func init() {
-	if GetMode() == shared.Fast {
-		getImpl = Get8uint32Fast
-		getDeltaImpl = Get8uint32DeltaFast
-	} else {
-		getImpl = Get8uint32Scalar
-		getDeltaImpl = Get8uint32DeltaScalar
+	defineOps()
+	if shared.SelfTestEnabled() {
+		SelfTest()
+	}
+	setImpls()
+	shared.OnReselect(setImpls)
+}
+
+// GetTier returns the tier of kernels currently in use. Unless forced
+// using SetTier, or to shared.TierScalar using shared.SetMode or
+// shared.Fallback, it is the highest tier supported by the CPU.
+func GetTier() shared.Tier {
+	if shared.GetMode() == shared.Normal {
+		return shared.TierScalar
+	}
+	if t := SupportedTier(); tier > t {
+		return t
+	}
+	return tier
+}
+
+// SupportedTier returns the highest tier of kernels supported by the CPU,
+// which is shared.TierScalar after shared.Fallback.
+func SupportedTier() shared.Tier {
+	if shared.FallbackReason() != "" {
+		return shared.TierScalar
+	}
+	return detectTier()
+}
+
+// SetTier forces the tier of kernels used by this package, e.g. to test a
+// lower tier on a CPU supporting a higher one. It returns
+// shared.ErrUnsupportedTier if the CPU does not support t. SetTier must
+// not be called concurrently with any other func of this package.
+func SetTier(t shared.Tier) error {
+	if t < shared.TierScalar || t > SupportedTier() {
+		return shared.ErrUnsupportedTier
 	}
+	tier = t
+	setImpls()
+	return nil
+}
+
+// setImpls selects the implementations used by the general funcs
+// according to the current tier and the registered kernels.
+func setImpls() {
+	t := GetTier()
+	getImpl = shared.Use(OpGet8uint32, t).Func.(func([]byte, []uint32, uint16))
+	getDeltaImpl = shared.Use(OpGet8uint32Delta, t).Func.(func([]byte, []uint32, uint16, uint32))
+	getInt32Impl = shared.Use(OpGet8int32, t).Func.(func([]byte, []int32, uint16))
+	getUint64Impl = shared.Use(OpGet8uint64, t).Func.(func([]byte, []uint64, uint16))
+	getUint64DeltaImpl = shared.Use(OpGet8uint64Delta, t).Func.(func([]byte, []uint64, uint16, uint64))
+	getUint16Impl = shared.Use(OpGet8uint16, t).Func.(func([]byte, []uint16, uint8))
+	getUint16DeltaImpl = shared.Use(OpGet8uint16Delta, t).Func.(func([]byte, []uint16, uint8, uint16))
+	get0124Impl = shared.Use(OpGet8uint32Variant0124, t).Func.(func([]byte, []uint32, uint16))
+	getDelta0124Impl = shared.Use(OpGet8uint32DeltaVariant0124, t).Func.(func([]byte, []uint32, uint16, uint32))
+	ctrlLenImpl = shared.Use(OpControlLen, t).Func.(func([]byte) int)
 }
 
 // Get8uint32 is a general func you can use to decode 8 uint32's at a time.
@@ -40,6 +110,15 @@ func Get8uint32Delta(in []byte, out []uint32, ctrl uint16, prev uint32) {
 	getDeltaImpl(in, out, ctrl, prev)
 }
 
+// Get8int32 is a general func you can use to decode 8 zigzag encoded int32's
+// at a time. It will use the fastest implementation available determined
+// during package initialization. If your CPU supports special hardware
+// instructions then it will use an accelerated version of Stream VByte.
+// Otherwise, the scalar implementation will be used as the fallback.
+func Get8int32(in []byte, out []int32, ctrl uint16) {
+	getInt32Impl(in, out, ctrl)
+}
+
 // Get8uint32Scalar will decode 8 uint32 values from in into out using the
 // Stream VByte format. Returns the number of bytes read from the input
 // buffer.
@@ -173,6 +252,46 @@ func Get4uint32DeltaScalar(in []byte, out []uint32, ctrl uint8, prev uint32) {
 	out[3] = decodeOne(in[len0+len1+len2:], len3) + out[2]
 }
 
+// Get8int32Scalar will decode 8 zigzag encoded int32 values from in into out
+// using the Stream VByte format.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get8int32Scalar(in []byte, out []int32, ctrl uint16) {
+	// bounds check hint to compiler
+	_ = out[7]
+
+	var nums [8]uint32
+	Get8uint32Scalar(in, nums[:], ctrl)
+	for i := range nums {
+		out[i] = unzigzag(nums[i])
+	}
+}
+
+// GetInt32Scalar decodes up to 4 zigzag encoded integers from in into out
+// using the Stream VByte format.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func GetInt32Scalar(in []byte, out []int32, ctrl uint8, count int) int {
+	if count > 4 {
+		count = 4
+	}
+
+	var nums [4]uint32
+	read := GetUint32Scalar(in, nums[:], ctrl, count)
+	for i := 0; i < count; i++ {
+		out[i] = unzigzag(nums[i])
+	}
+	return read
+}
+
+// unzigzag reverses the zigzag encoding, mapping 0, 1, 2, 3, 4 ...
+// back to 0, -1, 1, -2, 2 ...
+func unzigzag(num uint32) int32 {
+	return int32(num>>1) ^ -int32(num&1)
+}
+
 func decodeOne(b []byte, size uint8) uint32 {
 	switch size {
 	case 4:
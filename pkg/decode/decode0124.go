@@ -0,0 +1,146 @@
+package decode
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// Get8uint32Variant is a general func you can use to decode 8 uint32's at a
+// time using the provided variant of Stream VByte. It will use the fastest
+// implementation available determined during package initialization.
+func Get8uint32Variant(in []byte, out []uint32, ctrl uint16, v shared.Variant) {
+	if v == shared.Variant0124 {
+		get0124Impl(in, out, ctrl)
+		return
+	}
+	getImpl(in, out, ctrl)
+}
+
+// Get8uint32DeltaVariant is a general func you can use to decode 8
+// differentially coded uint32's at a time using the provided variant of
+// Stream VByte. It will use the fastest implementation available determined
+// during package initialization.
+func Get8uint32DeltaVariant(in []byte, out []uint32, ctrl uint16, prev uint32, v shared.Variant) {
+	if v == shared.Variant0124 {
+		getDelta0124Impl(in, out, ctrl, prev)
+		return
+	}
+	getDeltaImpl(in, out, ctrl, prev)
+}
+
+// Get8uint32Scalar0124 will decode 8 uint32 values from in into out using
+// the "0124" variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get8uint32Scalar0124(in []byte, out []uint32, ctrl uint16) {
+	lower := uint8(ctrl & 0xff)
+	upper := uint8(ctrl >> 8)
+	lowerSize := shared.ControlByteToSize0124(lower)
+	Get4uint32Scalar0124(in, out, lower)
+	Get4uint32Scalar0124(in[lowerSize:], out[4:], upper)
+}
+
+// Get4uint32Scalar0124 will decode 4 uint32 values from in into out using
+// the "0124" variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get4uint32Scalar0124(in []byte, out []uint32, ctrl uint8) {
+	sizes := shared.PerNumLenTable0124[ctrl]
+
+	len0 := sizes[0]
+	len1 := sizes[1]
+	len2 := sizes[2]
+
+	// bounds check hint to compiler
+	_ = out[3]
+	out[0] = decodeOne0124(in, len0)
+	out[1] = decodeOne0124(in[len0:], len1)
+	out[2] = decodeOne0124(in[len0+len1:], len2)
+	out[3] = decodeOne0124(in[len0+len1+len2:], sizes[3])
+}
+
+// Get8uint32DeltaScalar0124 will decode 8 uint32 values from in into out
+// and reconstruct the original values via differential coding. Prev
+// provides a way for you to indicate the base value for this batch of 8.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get8uint32DeltaScalar0124(in []byte, out []uint32, ctrl uint16, prev uint32) {
+	lower := uint8(ctrl & 0xff)
+	upper := uint8(ctrl >> 8)
+	lowerSize := shared.ControlByteToSize0124(lower)
+	Get4uint32DeltaScalar0124(in, out, lower, prev)
+	Get4uint32DeltaScalar0124(in[lowerSize:], out[4:], upper, out[3])
+}
+
+// Get4uint32DeltaScalar0124 will decode 4 uint32 values from in into out
+// and reconstruct the original values via differential coding. Prev
+// provides a way for you to indicate the base value for this batch of 4.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get4uint32DeltaScalar0124(in []byte, out []uint32, ctrl uint8, prev uint32) {
+	sizes := shared.PerNumLenTable0124[ctrl]
+
+	len0 := sizes[0]
+	len1 := sizes[1]
+	len2 := sizes[2]
+
+	// bounds check hint to compiler
+	_ = out[3]
+	out[0] = decodeOne0124(in, len0) + prev
+	out[1] = decodeOne0124(in[len0:], len1) + out[0]
+	out[2] = decodeOne0124(in[len0+len1:], len2) + out[1]
+	out[3] = decodeOne0124(in[len0+len1+len2:], sizes[3]) + out[2]
+}
+
+// GetUint32Scalar0124 decodes up to 4 integers from in into out using the
+// "0124" variant of Stream VByte. Returns the number of bytes read.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func GetUint32Scalar0124(in []byte, out []uint32, ctrl uint8, count int) int {
+	if count > 4 {
+		count = 4
+	}
+
+	sizes := shared.PerNumLenTable0124[ctrl]
+	total := 0
+	for i := 0; i < count; i++ {
+		out[i] = decodeOne0124(in[total:], sizes[i])
+		total += int(sizes[i])
+	}
+
+	return total
+}
+
+// GetUint32DeltaScalar0124 decodes up to 4 integers from in into out using
+// the "0124" variant of Stream VByte. It will reconstruct the original non
+// differentially encoded values. Returns the number of bytes read.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func GetUint32DeltaScalar0124(in []byte, out []uint32, ctrl uint8, count int, prev uint32) int {
+	if count > 4 {
+		count = 4
+	}
+
+	sizes := shared.PerNumLenTable0124[ctrl]
+	total := 0
+	for i := 0; i < count; i++ {
+		num := decodeOne0124(in[total:], sizes[i]) + prev
+		out[i] = num
+		prev = num
+		total += int(sizes[i])
+	}
+
+	return total
+}
+
+func decodeOne0124(b []byte, size uint8) uint32 {
+	if size == 0 {
+		return 0
+	}
+	return decodeOne(b, size)
+}
@@ -0,0 +1,79 @@
+package decode
+
+// Get8uint16 is a general func you can use to decode 8 uint16's at a time
+// using the 16-bit variant of Stream VByte. It will use the fastest
+// implementation available determined during package initialization.
+func Get8uint16(in []byte, out []uint16, ctrl uint8) {
+	getUint16Impl(in, out, ctrl)
+}
+
+// Get8uint16Delta is a general func you can use to decode 8 differentially
+// coded uint16's at a time using the 16-bit variant of Stream VByte. It will
+// use the fastest implementation available determined during package
+// initialization.
+func Get8uint16Delta(in []byte, out []uint16, ctrl uint8, prev uint16) {
+	getUint16DeltaImpl(in, out, ctrl, prev)
+}
+
+// Get8uint16Scalar will decode 8 uint16 values from in into out using the
+// 16-bit variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get8uint16Scalar(in []byte, out []uint16, ctrl uint8) {
+	GetUint16Scalar(in, out, ctrl, 8)
+}
+
+// Get8uint16DeltaScalar will decode 8 uint16 values from in into out and
+// reconstruct the original values via differential coding. Prev provides a
+// way for you to indicate the base value for this batch of 8.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get8uint16DeltaScalar(in []byte, out []uint16, ctrl uint8, prev uint16) {
+	GetUint16DeltaScalar(in, out, ctrl, 8, prev)
+}
+
+// GetUint16Scalar decodes up to 8 integers from in into out using the
+// 16-bit variant of Stream VByte. Returns the number of bytes read.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func GetUint16Scalar(in []byte, out []uint16, ctrl uint8, count int) int {
+	if count > 8 {
+		count = 8
+	}
+
+	total := 0
+	for i := 0; i < count; i++ {
+		if ctrl>>i&1 == 1 {
+			out[i] = uint16(in[total+1])<<8 | uint16(in[total])
+			total += 2
+		} else {
+			out[i] = uint16(in[total])
+			total++
+		}
+	}
+
+	return total
+}
+
+// GetUint16DeltaScalar decodes up to 8 integers from in into out using the
+// 16-bit variant of Stream VByte. It will reconstruct the original non
+// differentially encoded values. Returns the number of bytes read.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func GetUint16DeltaScalar(in []byte, out []uint16, ctrl uint8, count int, prev uint16) int {
+	if count > 8 {
+		count = 8
+	}
+
+	read := GetUint16Scalar(in, out, ctrl, count)
+	for i := 0; i < count; i++ {
+		out[i] += prev
+		prev = out[i]
+	}
+
+	return read
+}
@@ -0,0 +1,141 @@
+package decode
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// Get8uint64 is a general func you can use to decode 8 uint64's at a time
+// using the 64-bit variant of Stream VByte. It will use the fastest
+// implementation available determined during package initialization.
+func Get8uint64(in []byte, out []uint64, ctrl uint16) {
+	getUint64Impl(in, out, ctrl)
+}
+
+// Get8uint64Delta is a general func you can use to decode 8 differentially
+// coded uint64's at a time using the 64-bit variant of Stream VByte. It will
+// use the fastest implementation available determined during package
+// initialization.
+func Get8uint64Delta(in []byte, out []uint64, ctrl uint16, prev uint64) {
+	getUint64DeltaImpl(in, out, ctrl, prev)
+}
+
+// Get8uint64Scalar will decode 8 uint64 values from in into out using the
+// 64-bit variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get8uint64Scalar(in []byte, out []uint64, ctrl uint16) {
+	lower := uint8(ctrl & 0xff)
+	upper := uint8(ctrl >> 8)
+	lowerSize := shared.ControlByteToSize64(lower)
+	Get4uint64Scalar(in, out, lower)
+	Get4uint64Scalar(in[lowerSize:], out[4:], upper)
+}
+
+// Get4uint64Scalar will decode 4 uint64 values from in into out using the
+// 64-bit variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get4uint64Scalar(in []byte, out []uint64, ctrl uint8) {
+	sizes := shared.PerNumLenTable64[ctrl]
+
+	len0 := int(sizes[0])
+	len1 := int(sizes[1])
+	len2 := int(sizes[2])
+
+	// bounds check hint to compiler
+	_ = out[3]
+	out[0] = decodeOne64(in, sizes[0])
+	out[1] = decodeOne64(in[len0:], sizes[1])
+	out[2] = decodeOne64(in[len0+len1:], sizes[2])
+	out[3] = decodeOne64(in[len0+len1+len2:], sizes[3])
+}
+
+// Get8uint64DeltaScalar will decode 8 uint64 values from in into out and
+// reconstruct the original values via differential coding. Prev provides a
+// way for you to indicate the base value for this batch of 8.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get8uint64DeltaScalar(in []byte, out []uint64, ctrl uint16, prev uint64) {
+	lower := uint8(ctrl & 0xff)
+	upper := uint8(ctrl >> 8)
+	lowerSize := shared.ControlByteToSize64(lower)
+	Get4uint64DeltaScalar(in, out, lower, prev)
+	Get4uint64DeltaScalar(in[lowerSize:], out[4:], upper, out[3])
+}
+
+// Get4uint64DeltaScalar will decode 4 uint64 values from in into out and
+// reconstruct the original values via differential coding. Prev provides a
+// way for you to indicate the base value for this batch of 4.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Get4uint64DeltaScalar(in []byte, out []uint64, ctrl uint8, prev uint64) {
+	sizes := shared.PerNumLenTable64[ctrl]
+
+	len0 := int(sizes[0])
+	len1 := int(sizes[1])
+	len2 := int(sizes[2])
+
+	// bounds check hint to compiler
+	_ = out[3]
+	out[0] = decodeOne64(in, sizes[0]) + prev
+	out[1] = decodeOne64(in[len0:], sizes[1]) + out[0]
+	out[2] = decodeOne64(in[len0+len1:], sizes[2]) + out[1]
+	out[3] = decodeOne64(in[len0+len1+len2:], sizes[3]) + out[2]
+}
+
+// GetUint64Scalar decodes up to 4 integers from in into out using the
+// 64-bit variant of Stream VByte. Returns the number of bytes read.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func GetUint64Scalar(in []byte, out []uint64, ctrl uint8, count int) int {
+	if count > 4 {
+		count = 4
+	}
+
+	sizes := shared.PerNumLenTable64[ctrl]
+	total := 0
+	for i := 0; i < count; i++ {
+		out[i] = decodeOne64(in[total:], sizes[i])
+		total += int(sizes[i])
+	}
+
+	return total
+}
+
+// GetUint64DeltaScalar decodes up to 4 integers from in into out using the
+// 64-bit variant of Stream VByte. It will reconstruct the original non
+// differentially encoded values. Returns the number of bytes read.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func GetUint64DeltaScalar(in []byte, out []uint64, ctrl uint8, count int, prev uint64) int {
+	if count > 4 {
+		count = 4
+	}
+
+	sizes := shared.PerNumLenTable64[ctrl]
+	total := 0
+	for i := 0; i < count; i++ {
+		num := decodeOne64(in[total:], sizes[i]) + prev
+		out[i] = num
+		prev = num
+		total += int(sizes[i])
+	}
+
+	return total
+}
+
+func decodeOne64(b []byte, size uint8) uint64 {
+	switch size {
+	case 8:
+		return uint64(decodeOne(b, 4)) | uint64(decodeOne(b[4:], 4))<<32
+	case 4, 2, 1:
+		return uint64(decodeOne(b, size))
+	}
+	panic("impossible")
+}
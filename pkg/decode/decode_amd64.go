@@ -10,15 +10,68 @@ import (
 	"golang.org/x/sys/cpu"
 )
 
-// GetMode performs a check to see if the current ISA supports
-// the below decoding funcs.
+// GetMode reports whether the below decoding funcs are in use, i.e.
+// whether the current tier is shared.TierAVX2.
 func GetMode() shared.PerformanceMode {
-	if cpu.X86.HasAVX {
+	if GetTier() == shared.TierAVX2 {
 		return shared.Fast
 	}
 	return shared.Normal
 }
 
+// detectTier performs a check to see which tier of kernels the
+// current ISA supports.
+func detectTier() shared.Tier {
+	switch {
+	case cpu.X86.HasAVX:
+		return shared.TierAVX2
+	case cpu.X86.HasSSSE3 && cpu.X86.HasSSE41:
+		return shared.TierSSE41
+	}
+	return shared.TierScalar
+}
+
+// selfTestKernels returns the accelerated kernels supported by the CPU
+// that SelfTest checks.
+func selfTestKernels() []selfTestKernel {
+	var kernels []selfTestKernel
+	if detectTier() >= shared.TierSSE41 {
+		kernels = append(kernels, selfTestKernel{shared.KernelSSE41, Get8uint32SSE, Get8uint32DeltaSSE})
+	}
+	if detectTier() >= shared.TierAVX2 {
+		kernels = append(kernels, selfTestKernel{shared.KernelAVX2, Get8uint32Fast, Get8uint32DeltaFast})
+	}
+	return kernels
+}
+
+// defineFastOps adds the SSE and AVX kernels to the ops of this package.
+func defineFastOps() {
+	var (
+		sse = []string{shared.FeatureSSSE3, shared.FeatureSSE41}
+		avx = []string{shared.FeatureAVX}
+	)
+	kernel := func(name string, t shared.Tier, features []string, fn interface{}) shared.Kernel {
+		return shared.Kernel{Name: name, Tier: t, Features: features, Func: fn}
+	}
+
+	shared.Define(OpGet8uint32,
+		kernel(shared.KernelSSE41, shared.TierSSE41, sse, Get8uint32SSE),
+		kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8uint32Fast),
+	)
+	shared.Define(OpGet8uint32Delta,
+		kernel(shared.KernelSSE41, shared.TierSSE41, sse, Get8uint32DeltaSSE),
+		kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8uint32DeltaFast),
+	)
+	shared.Define(OpGet8int32, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8int32Fast))
+	shared.Define(OpGet8uint64, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8uint64Fast))
+	shared.Define(OpGet8uint64Delta, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8uint64DeltaFast))
+	shared.Define(OpGet8uint16, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8uint16Fast))
+	shared.Define(OpGet8uint16Delta, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8uint16DeltaFast))
+	shared.Define(OpGet8uint32Variant0124, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8uint32Fast0124))
+	shared.Define(OpGet8uint32DeltaVariant0124, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Get8uint32DeltaFast0124))
+	shared.Define(OpControlLen, kernel(shared.KernelAVX2, shared.TierAVX2, avx, ControlLenFast))
+}
+
 // Get8uint32Fast binds to get8uint32Fast which is implemented in
 // assembly.
 func Get8uint32Fast(in []byte, out []uint32, ctrl uint16) {
@@ -38,6 +91,218 @@ func Get8uint32DeltaFast(in []byte, out []uint32, ctrl uint16, prev uint32) {
 	)
 }
 
+// GetNuint32Fast decodes up to 4 integers from in into out the same way
+// as GetUint32Scalar, e.g. the last integers of a stream, binding to
+// GetNuint32FastAsm which is implemented in assembly. Returns the number
+// of bytes read from the input buffer.
+func GetNuint32Fast(in []byte, out []uint32, ctrl uint8, count int) int {
+	if count == 0 {
+		return 0
+	}
+
+	if count > 4 {
+		count = 4
+	}
+
+	return GetNuint32FastAsm(in, out[:count], ctrl, count,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// GetNuint32DeltaFast decodes up to 4 integers from in into out the same
+// way as GetUint32DeltaScalar, binding to GetNuint32DeltaFastAsm which is
+// implemented in assembly. Returns the number of bytes read from the input
+// buffer.
+func GetNuint32DeltaFast(in []byte, out []uint32, ctrl uint8, count int, prev uint32) int {
+	if count == 0 {
+		return 0
+	}
+
+	if count > 4 {
+		count = 4
+	}
+
+	return GetNuint32DeltaFastAsm(
+		in, out[:count], ctrl, count, prev,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// Get8int32Fast binds to Get8int32FastAsm which is implemented in
+// assembly.
+func Get8int32Fast(in []byte, out []int32, ctrl uint16) {
+	Get8int32FastAsm(in, out, ctrl,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// Get8uint64Fast binds to Get8uint64FastAsm which is implemented in
+// assembly.
+func Get8uint64Fast(in []byte, out []uint64, ctrl uint16) {
+	Get8uint64FastAsm(in, out, ctrl,
+		shared.DecodeShuffleTable64,
+		shared.PerPairLenTable64,
+	)
+}
+
+// Get8uint64DeltaFast binds to Get8uint64DeltaFastAsm which is
+// implemented in assembly.
+func Get8uint64DeltaFast(in []byte, out []uint64, ctrl uint16, prev uint64) {
+	Get8uint64DeltaFastAsm(
+		in, out, ctrl, prev,
+		shared.DecodeShuffleTable64,
+		shared.PerPairLenTable64,
+	)
+}
+
+// Get8uint16Fast binds to Get8uint16FastAsm which is implemented in
+// assembly.
+func Get8uint16Fast(in []byte, out []uint16, ctrl uint8) {
+	Get8uint16FastAsm(in, out, ctrl, shared.DecodeShuffleTable16)
+}
+
+// Get8uint16DeltaFast binds to Get8uint16DeltaFastAsm which is
+// implemented in assembly.
+func Get8uint16DeltaFast(in []byte, out []uint16, ctrl uint8, prev uint16) {
+	Get8uint16DeltaFastAsm(in, out, ctrl, prev, shared.DecodeShuffleTable16)
+}
+
+// Get8uint32Fast0124 decodes 8 uint32s of the "0124" variant. The
+// decoding kernels are driven entirely by the shuffle and length tables,
+// so it binds to Get8uint32FastAsm using the "0124" tables. The all
+// 0xff shuffle masks of zero length integers leave them zeroed.
+func Get8uint32Fast0124(in []byte, out []uint32, ctrl uint16) {
+	Get8uint32FastAsm(in, out, ctrl,
+		shared.DecodeShuffleTable0124,
+		shared.PerControlLenTable0124,
+	)
+}
+
+// Get8uint32DeltaFast0124 decodes 8 differentially coded uint32s of the
+// "0124" variant by binding to Get8uint32DeltaFastAsm using the "0124"
+// tables.
+func Get8uint32DeltaFast0124(in []byte, out []uint32, ctrl uint16, prev uint32) {
+	Get8uint32DeltaFastAsm(
+		in, out, ctrl, prev,
+		shared.DecodeShuffleTable0124,
+		shared.PerControlLenTable0124,
+	)
+}
+
+// ControlLenFast returns the number of data bytes described by the
+// provided control bytes. It binds to ControlLenFastAsm for all but the
+// last len(ctrls)%16 control bytes.
+func ControlLenFast(ctrls []byte) int {
+	bulk := len(ctrls) &^ 15
+	return 4*bulk + ControlLenFastAsm(ctrls) + ControlLenScalar(ctrls[bulk:])
+}
+
+// SumUint32Fast returns the sum of the integers described by every pair of
+// control bytes in ctrls along with the number of data bytes read. It
+// binds to SumUint32FastAsm.
+func SumUint32Fast(ctrls, data []byte) (uint64, int) {
+	return SumUint32FastAsm(ctrls, data,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// SumUint32DeltaFast works similarly to SumUint32Fast except that the
+// integers are differentially coded. It also returns the last integer
+// reconstructed, or prev if ctrls is empty. It binds to
+// SumUint32DeltaFastAsm.
+func SumUint32DeltaFast(ctrls, data []byte, prev uint32) (uint64, uint32, int) {
+	return SumUint32DeltaFastAsm(ctrls, data, prev,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// MinUint32Fast returns the minimum of the integers described by every pair of
+// control bytes in ctrls along with the number of data bytes read. It
+// binds to MinUint32FastAsm.
+func MinUint32Fast(ctrls, data []byte) (uint32, int) {
+	return MinUint32FastAsm(ctrls, data,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// MinUint32DeltaFast works similarly to MinUint32Fast except that the
+// integers are differentially coded. It also returns the last integer
+// reconstructed, or prev if ctrls is empty. It binds to
+// MinUint32DeltaFastAsm.
+func MinUint32DeltaFast(ctrls, data []byte, prev uint32) (uint32, uint32, int) {
+	return MinUint32DeltaFastAsm(ctrls, data, prev,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// MaxUint32Fast returns the maximum of the integers described by every pair of
+// control bytes in ctrls along with the number of data bytes read. It
+// binds to MaxUint32FastAsm.
+func MaxUint32Fast(ctrls, data []byte) (uint32, int) {
+	return MaxUint32FastAsm(ctrls, data,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// MaxUint32DeltaFast works similarly to MaxUint32Fast except that the
+// integers are differentially coded. It also returns the last integer
+// reconstructed, or prev if ctrls is empty. It binds to
+// MaxUint32DeltaFastAsm.
+func MaxUint32DeltaFast(ctrls, data []byte, prev uint32) (uint32, uint32, int) {
+	return MaxUint32DeltaFastAsm(ctrls, data, prev,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// XorUint32Fast returns the bitwise xor of the integers described by every
+// pair of control bytes in ctrls along with the number of data bytes read.
+// It binds to XorUint32FastAsm.
+func XorUint32Fast(ctrls, data []byte) (uint32, int) {
+	return XorUint32FastAsm(ctrls, data,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// XorUint32DeltaFast works similarly to XorUint32Fast except that the
+// integers are differentially coded. It also returns the last integer
+// reconstructed, or prev if ctrls is empty. It binds to
+// XorUint32DeltaFastAsm.
+func XorUint32DeltaFast(ctrls, data []byte, prev uint32) (uint32, uint32, int) {
+	return XorUint32DeltaFastAsm(ctrls, data, prev,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// Get8uint32SSE binds to Get8uint32SSEAsm which is implemented in
+// assembly.
+func Get8uint32SSE(in []byte, out []uint32, ctrl uint16) {
+	Get8uint32SSEAsm(in, out, ctrl,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// Get8uint32DeltaSSE binds to Get8uint32DeltaSSEAsm which is implemented
+// in assembly.
+func Get8uint32DeltaSSE(in []byte, out []uint32, ctrl uint16, prev uint32) {
+	Get8uint32DeltaSSEAsm(
+		in, out, ctrl, prev,
+		shared.DecodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
 // Get8uint32FastAsm uses the provided 16-bit control to load the
 // appropriate decoding shuffle masks and performs a shuffle
 // operation on the provided input bytes. This in effect decompresses
@@ -65,3 +330,208 @@ func Get8uint32DeltaFastAsm(
 	in []byte, out []uint32, ctrl uint16, prev uint32,
 	shuffle *[256][16]uint8, lenTable *[256]uint8,
 )
+
+// Get8int32FastAsm works similarly to Get8uint32FastAsm with the
+// exception that prior to writing the uncompressed integers out
+// to the output slice, they are zigzag decoded back into signed
+// integers. The zigzag decoding is as follows:
+//
+// Input:           [A B C D]
+// Sign:            [-(A&1) -(B&1) -(C&1) -(D&1)]
+// Shift right:     [A>>1 B>>1 C>>1 D>>1]
+// Xor above two:   [(A>>1)^-(A&1) ...]
+//go:noescape
+func Get8int32FastAsm(
+	in []byte, out []int32, ctrl uint16,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+)
+
+// Get8uint64FastAsm decodes 8 uint64s using the 64-bit variant of
+// Stream VByte. Every 4-bit nibble of the provided control describes
+// a pair of integers and is used to load the appropriate decoding
+// shuffle mask from shared.DecodeShuffleTable64 and the length of the
+// pair from shared.PerPairLenTable64. The result is written to the
+// provided output slice.
+//go:noescape
+func Get8uint64FastAsm(
+	in []byte, out []uint64, ctrl uint16,
+	shuffle *[16][16]uint8, lenTable *[16]uint8,
+)
+
+// Get8uint64DeltaFastAsm works similarly to Get8uint64FastAsm with the
+// exception that the original values are reconstructed from the diffs
+// pair by pair prior to writing them out:
+//
+// Input:           [A B]
+// Input Shifted:   [- A]
+// Add above two:   [A AB]
+// Add Prev:        [PA PAB]
+// Next Prev:       [PAB PAB]
+//go:noescape
+func Get8uint64DeltaFastAsm(
+	in []byte, out []uint64, ctrl uint16, prev uint64,
+	shuffle *[16][16]uint8, lenTable *[16]uint8,
+)
+
+// Get8uint16FastAsm uses the provided 8-bit control to load the
+// appropriate decoding shuffle mask from shared.DecodeShuffleTable16
+// and decompresses the 8 uint16s described by it with a single
+// shuffle operation. The result is written to the provided output
+// slice.
+//go:noescape
+func Get8uint16FastAsm(in []byte, out []uint16, ctrl uint8, shuffle *[256][16]uint8)
+
+// Get8uint16DeltaFastAsm works similarly to Get8uint16FastAsm with the
+// exception that the original values are reconstructed from the diffs
+// prior to writing them out:
+//
+// Input:           [A B C D E F G H]
+// Add Prev:        [PA B C D E F G H]
+// Input Shifted:   [- PA B C D E F G]
+// Add above two:   [PA PAB BC CD DE EF FG GH]
+// Repeat with shifts of 4 and 8 bytes.
+//go:noescape
+func Get8uint16DeltaFastAsm(in []byte, out []uint16, ctrl uint8, prev uint16, shuffle *[256][16]uint8)
+
+// ControlLenFastAsm sums up the 2-bit codes of the first len(ctrls)&^15
+// control bytes, 16 at a time. Every code is one less than the length of
+// the integer it describes. The codes of a control byte are summed up
+// in-register as follows:
+//
+// Input:           [c3 c2 c1 c0]
+// Mask 0x33:       [0 c2 0 c0]
+// Shift, Mask:     [0 c3 0 c1]
+// Add above two:   [c2+c3 c0+c1]
+// Add nibbles:     [c0+c1+c2+c3]
+//
+// The resulting bytes are then accumulated using VPSADBW.
+//go:noescape
+func ControlLenFastAsm(ctrls []byte) int
+
+// SumUint32FastAsm decodes the 8 uint32s described by every pair of
+// control bytes in ctrls and adds them up in-register without writing
+// them out to memory. The uint32s are zero extended into two 64-bit lanes
+// prior to adding them up, so the sum does not wrap around at 32 bits:
+//
+// Input:           [A B C D]
+// Unpack Low:      [A - B -]
+// Unpack High:     [C - D -]
+// Add to Sum:      [S+A+C S+B+D]
+//
+// Returns the sum along with the number of data bytes read.
+//go:noescape
+func SumUint32FastAsm(
+	ctrls, data []byte,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint64, n int)
+
+// SumUint32DeltaFastAsm works similarly to SumUint32FastAsm with the
+// exception that the original values are reconstructed from the diffs
+// in-register prior to reducing them, the same way Get8uint32DeltaFastAsm
+// does. The last reconstructed integer is carried over from one batch to
+// the next and returned as well.
+//go:noescape
+func SumUint32DeltaFastAsm(
+	ctrls, data []byte, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint64, last uint32, n int)
+
+// MinUint32FastAsm decodes the 8 uint32s described by every pair of
+// control bytes in ctrls and keeps a running minimum of them in-register
+// without writing them out to memory. Returns the minimum along with the
+// number of data bytes read.
+//go:noescape
+func MinUint32FastAsm(
+	ctrls, data []byte,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint32, n int)
+
+// MinUint32DeltaFastAsm works similarly to MinUint32FastAsm with the
+// exception that the original values are reconstructed from the diffs
+// in-register prior to reducing them, the same way Get8uint32DeltaFastAsm
+// does. The last reconstructed integer is carried over from one batch to
+// the next and returned as well.
+//go:noescape
+func MinUint32DeltaFastAsm(
+	ctrls, data []byte, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint32, last uint32, n int)
+
+// MaxUint32FastAsm decodes the 8 uint32s described by every pair of
+// control bytes in ctrls and keeps a running maximum of them in-register
+// without writing them out to memory. Returns the maximum along with the
+// number of data bytes read.
+//go:noescape
+func MaxUint32FastAsm(
+	ctrls, data []byte,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint32, n int)
+
+// MaxUint32DeltaFastAsm works similarly to MaxUint32FastAsm with the
+// exception that the original values are reconstructed from the diffs
+// in-register prior to reducing them, the same way Get8uint32DeltaFastAsm
+// does. The last reconstructed integer is carried over from one batch to
+// the next and returned as well.
+//go:noescape
+func MaxUint32DeltaFastAsm(
+	ctrls, data []byte, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint32, last uint32, n int)
+
+// XorUint32FastAsm decodes the 8 uint32s described by every pair of
+// control bytes in ctrls and xors them together in-register without
+// writing them out to memory. Returns the result along with the number of
+// data bytes read.
+//go:noescape
+func XorUint32FastAsm(
+	ctrls, data []byte,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint32, n int)
+
+// XorUint32DeltaFastAsm works similarly to XorUint32FastAsm with the
+// exception that the original values are reconstructed from the diffs
+// in-register prior to reducing them, the same way Get8uint32DeltaFastAsm
+// does. The last reconstructed integer is carried over from one batch to
+// the next and returned as well.
+//go:noescape
+func XorUint32DeltaFastAsm(
+	ctrls, data []byte, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint32, last uint32, n int)
+
+// Get8uint32SSEAsm works exactly like Get8uint32FastAsm using legacy
+// SSE instructions only, for CPUs without AVX.
+//go:noescape
+func Get8uint32SSEAsm(
+	in []byte, out []uint32, ctrl uint16,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+)
+
+// Get8uint32DeltaSSEAsm works exactly like Get8uint32DeltaFastAsm using
+// legacy SSE instructions only, for CPUs without AVX.
+//go:noescape
+func Get8uint32DeltaSSEAsm(
+	in []byte, out []uint32, ctrl uint16, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+)
+
+// GetNuint32FastAsm works similarly to Get8uint32FastAsm except that it
+// only decodes the first count, 1 to 4, integers described by the provided
+// 8-bit control. The codes past count are masked off the control, which
+// gives the exact number of data bytes to load. They are loaded in 8, 4, 2
+// and 1 byte loads so that nothing is read past them, and the integers are
+// written out using VMASKMOVPS so that nothing is written past them either.
+// Returns the number of data bytes read.
+//go:noescape
+func GetNuint32FastAsm(
+	in []byte, out []uint32, ctrl uint8, count int,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (n int)
+
+// GetNuint32DeltaFastAsm works similarly to GetNuint32FastAsm with the
+// integers being reconstructed as in Get8uint32DeltaFastAsm.
+//go:noescape
+func GetNuint32DeltaFastAsm(
+	in []byte, out []uint32, ctrl uint8, count int, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (n int)
@@ -2,6 +2,35 @@
 
 #include "textflag.h"
 
+DATA countMask<>+0(SB)/4, $0x00000000
+DATA countMask<>+4(SB)/4, $0x00000000
+DATA countMask<>+8(SB)/4, $0x00000000
+DATA countMask<>+12(SB)/4, $0x00000000
+DATA countMask<>+16(SB)/4, $0xffffffff
+DATA countMask<>+20(SB)/4, $0x00000000
+DATA countMask<>+24(SB)/4, $0x00000000
+DATA countMask<>+28(SB)/4, $0x00000000
+DATA countMask<>+32(SB)/4, $0xffffffff
+DATA countMask<>+36(SB)/4, $0xffffffff
+DATA countMask<>+40(SB)/4, $0x00000000
+DATA countMask<>+44(SB)/4, $0x00000000
+DATA countMask<>+48(SB)/4, $0xffffffff
+DATA countMask<>+52(SB)/4, $0xffffffff
+DATA countMask<>+56(SB)/4, $0xffffffff
+DATA countMask<>+60(SB)/4, $0x00000000
+DATA countMask<>+64(SB)/4, $0xffffffff
+DATA countMask<>+68(SB)/4, $0xffffffff
+DATA countMask<>+72(SB)/4, $0xffffffff
+DATA countMask<>+76(SB)/4, $0xffffffff
+GLOBL countMask<>(SB), RODATA|NOPTR, $80
+
+DATA ctrlMask<>+0(SB)/1, $0x00
+DATA ctrlMask<>+1(SB)/1, $0x03
+DATA ctrlMask<>+2(SB)/1, $0x0f
+DATA ctrlMask<>+3(SB)/1, $0x3f
+DATA ctrlMask<>+4(SB)/1, $0xff
+GLOBL ctrlMask<>(SB), RODATA|NOPTR, $5
+
 // func Get8uint32FastAsm(in []byte, out []uint32, ctrl uint16, shuffle *[256][16]uint8, lenTable *[256]uint8)
 // Requires: AVX
 TEXT ·Get8uint32FastAsm(SB), NOSPLIT, $0-72
@@ -69,3 +98,929 @@ TEXT ·Get8uint32DeltaFastAsm(SB), NOSPLIT, $0-72
 	VMOVDQU      X0, (AX)
 	VMOVDQU      X1, 16(AX)
 	RET
+
+// func Get8int32FastAsm(in []byte, out []int32, ctrl uint16, shuffle *[256][16]uint8, lenTable *[256]uint8)
+// Requires: AVX
+TEXT ·Get8int32FastAsm(SB), NOSPLIT, $0-72
+	MOVWQZX ctrl+48(FP), AX
+	MOVQ    shuffle+56(FP), CX
+	MOVBQZX AL, DX
+	SHLQ    $0x04, DX
+	ADDQ    CX, DX
+	MOVWQZX AX, BX
+	SHRQ    $0x08, BX
+	SHLQ    $0x04, BX
+	ADDQ    CX, BX
+	MOVQ    in_base+0(FP), CX
+	MOVQ    CX, SI
+	MOVQ    lenTable+64(FP), DI
+	MOVBQZX AL, AX
+	ADDQ    DI, AX
+	MOVBQZX (AX), AX
+	ADDQ    AX, SI
+	VLDDQU  (CX), X0
+	VLDDQU  (SI), X1
+	VPSHUFB (DX), X0, X0
+	VPSHUFB (BX), X1, X1
+	VPSLLD  $0x1f, X0, X2
+	VPSRAD  $0x1f, X2, X2
+	VPSRLD  $0x01, X0, X0
+	VPXOR   X2, X0, X0
+	VPSLLD  $0x1f, X1, X2
+	VPSRAD  $0x1f, X2, X2
+	VPSRLD  $0x01, X1, X1
+	VPXOR   X2, X1, X1
+	MOVQ    out_base+24(FP), AX
+	VMOVDQU X0, (AX)
+	VMOVDQU X1, 16(AX)
+	RET
+
+// func Get8uint64FastAsm(in []byte, out []uint64, ctrl uint16, shuffle *[16][16]uint8, lenTable *[16]uint8)
+// Requires: AVX
+TEXT ·Get8uint64FastAsm(SB), NOSPLIT, $0-72
+	MOVWQZX ctrl+48(FP), AX
+	MOVQ    shuffle+56(FP), CX
+	MOVQ    lenTable+64(FP), DX
+	MOVQ    in_base+0(FP), BX
+	MOVQ    AX, SI
+	ANDQ    $0x0f, SI
+	VLDDQU  (BX), X0
+	MOVBQZX (DX)(SI*1), DI
+	ADDQ    DI, BX
+	SHLQ    $0x04, SI
+	VPSHUFB (CX)(SI*1), X0, X0
+	MOVQ    AX, SI
+	SHRQ    $0x04, SI
+	ANDQ    $0x0f, SI
+	VLDDQU  (BX), X1
+	MOVBQZX (DX)(SI*1), DI
+	ADDQ    DI, BX
+	SHLQ    $0x04, SI
+	VPSHUFB (CX)(SI*1), X1, X1
+	MOVQ    AX, SI
+	SHRQ    $0x08, SI
+	ANDQ    $0x0f, SI
+	VLDDQU  (BX), X2
+	MOVBQZX (DX)(SI*1), DI
+	ADDQ    DI, BX
+	SHLQ    $0x04, SI
+	VPSHUFB (CX)(SI*1), X2, X2
+	MOVQ    AX, SI
+	SHRQ    $0x0c, SI
+	ANDQ    $0x0f, SI
+	VLDDQU  (BX), X3
+	SHLQ    $0x04, SI
+	VPSHUFB (CX)(SI*1), X3, X3
+	MOVQ    out_base+24(FP), AX
+	VMOVDQU X0, (AX)
+	VMOVDQU X1, 16(AX)
+	VMOVDQU X2, 32(AX)
+	VMOVDQU X3, 48(AX)
+	RET
+
+// func Get8uint64DeltaFastAsm(in []byte, out []uint64, ctrl uint16, prev uint64, shuffle *[16][16]uint8, lenTable *[16]uint8)
+// Requires: AVX
+TEXT ·Get8uint64DeltaFastAsm(SB), NOSPLIT, $0-80
+	MOVWQZX     ctrl+48(FP), AX
+	MOVQ        shuffle+64(FP), CX
+	MOVQ        lenTable+72(FP), DX
+	MOVQ        in_base+0(FP), BX
+	MOVQ        AX, SI
+	ANDQ        $0x0f, SI
+	VLDDQU      (BX), X0
+	MOVBQZX     (DX)(SI*1), DI
+	ADDQ        DI, BX
+	SHLQ        $0x04, SI
+	VPSHUFB     (CX)(SI*1), X0, X0
+	MOVQ        AX, SI
+	SHRQ        $0x04, SI
+	ANDQ        $0x0f, SI
+	VLDDQU      (BX), X1
+	MOVBQZX     (DX)(SI*1), DI
+	ADDQ        DI, BX
+	SHLQ        $0x04, SI
+	VPSHUFB     (CX)(SI*1), X1, X1
+	MOVQ        AX, SI
+	SHRQ        $0x08, SI
+	ANDQ        $0x0f, SI
+	VLDDQU      (BX), X2
+	MOVBQZX     (DX)(SI*1), DI
+	ADDQ        DI, BX
+	SHLQ        $0x04, SI
+	VPSHUFB     (CX)(SI*1), X2, X2
+	MOVQ        AX, SI
+	SHRQ        $0x0c, SI
+	ANDQ        $0x0f, SI
+	VLDDQU      (BX), X3
+	SHLQ        $0x04, SI
+	VPSHUFB     (CX)(SI*1), X3, X3
+	VMOVQ       prev+56(FP), X4
+	VPUNPCKLQDQ X4, X4, X4
+	VPSLLDQ     $0x08, X0, X5
+	VPADDQ      X0, X5, X0
+	VPADDQ      X0, X4, X0
+	VPUNPCKHQDQ X0, X0, X4
+	VPSLLDQ     $0x08, X1, X5
+	VPADDQ      X1, X5, X1
+	VPADDQ      X1, X4, X1
+	VPUNPCKHQDQ X1, X1, X4
+	VPSLLDQ     $0x08, X2, X5
+	VPADDQ      X2, X5, X2
+	VPADDQ      X2, X4, X2
+	VPUNPCKHQDQ X2, X2, X4
+	VPSLLDQ     $0x08, X3, X5
+	VPADDQ      X3, X5, X3
+	VPADDQ      X3, X4, X3
+	VPUNPCKHQDQ X3, X3, X4
+	MOVQ        out_base+24(FP), AX
+	VMOVDQU     X0, (AX)
+	VMOVDQU     X1, 16(AX)
+	VMOVDQU     X2, 32(AX)
+	VMOVDQU     X3, 48(AX)
+	RET
+
+// func Get8uint16FastAsm(in []byte, out []uint16, ctrl uint8, shuffle *[256][16]uint8)
+// Requires: AVX
+TEXT ·Get8uint16FastAsm(SB), NOSPLIT, $0-64
+	MOVBQZX ctrl+48(FP), AX
+	SHLQ    $0x04, AX
+	MOVQ    shuffle+56(FP), CX
+	ADDQ    CX, AX
+	MOVQ    in_base+0(FP), CX
+	VLDDQU  (CX), X0
+	VPSHUFB (AX), X0, X0
+	MOVQ    out_base+24(FP), AX
+	VMOVDQU X0, (AX)
+	RET
+
+// func Get8uint16DeltaFastAsm(in []byte, out []uint16, ctrl uint8, prev uint16, shuffle *[256][16]uint8)
+// Requires: AVX
+TEXT ·Get8uint16DeltaFastAsm(SB), NOSPLIT, $0-64
+	MOVBQZX ctrl+48(FP), AX
+	SHLQ    $0x04, AX
+	MOVQ    shuffle+56(FP), CX
+	ADDQ    CX, AX
+	MOVQ    in_base+0(FP), CX
+	VLDDQU  (CX), X0
+	VPSHUFB (AX), X0, X0
+	MOVWLZX prev+50(FP), AX
+	VMOVD   AX, X1
+	VPADDW  X1, X0, X0
+	VPSLLDQ $0x02, X0, X1
+	VPADDW  X1, X0, X0
+	VPSLLDQ $0x04, X0, X1
+	VPADDW  X1, X0, X0
+	VPSLLDQ $0x08, X0, X1
+	VPADDW  X1, X0, X0
+	MOVQ    out_base+24(FP), AX
+	VMOVDQU X0, (AX)
+	RET
+
+// func ControlLenFastAsm(ctrls []byte) int
+// Requires: AVX
+TEXT ·ControlLenFastAsm(SB), NOSPLIT, $0-32
+	MOVQ     ctrls_base+0(FP), AX
+	MOVQ     ctrls_len+8(FP), CX
+	SHRQ     $0x04, CX
+	VMOVDDUP mask33<>+0(SB), X0
+	VMOVDDUP mask0F<>+0(SB), X1
+	VPXOR    X2, X2, X2
+	VPXOR    X3, X3, X3
+
+loop:
+	TESTQ   CX, CX
+	JZ      done
+	VMOVDQU (AX), X4
+	VPSRLW  $0x02, X4, X5
+	VPAND   X0, X4, X4
+	VPAND   X0, X5, X5
+	VPADDB  X5, X4, X4
+	VPSRLW  $0x04, X4, X5
+	VPAND   X1, X4, X4
+	VPAND   X1, X5, X5
+	VPADDB  X5, X4, X4
+	VPSADBW X2, X4, X4
+	VPADDQ  X4, X3, X3
+	ADDQ    $0x10, AX
+	DECQ    CX
+	JMP     loop
+
+done:
+	VPSHUFD $0x4e, X3, X0
+	VPADDQ  X0, X3, X3
+	VMOVQ   X3, AX
+	MOVQ    AX, ret+24(FP)
+	RET
+
+DATA mask33<>+0(SB)/8, $0x3333333333333333
+GLOBL mask33<>(SB), RODATA|NOPTR, $8
+
+DATA mask0F<>+0(SB)/8, $0x0f0f0f0f0f0f0f0f
+GLOBL mask0F<>(SB), RODATA|NOPTR, $8
+
+// func Get8uint32SSEAsm(in []byte, out []uint32, ctrl uint16, shuffle *[256][16]uint8, lenTable *[256]uint8)
+// Requires: SSE2, SSSE3
+TEXT ·Get8uint32SSEAsm(SB), NOSPLIT, $0-72
+	MOVWQZX ctrl+48(FP), AX
+	MOVQ    shuffle+56(FP), CX
+	MOVBQZX AL, DX
+	SHLQ    $0x04, DX
+	ADDQ    CX, DX
+	MOVOU   (DX), X0
+	MOVWQZX AX, DX
+	SHRQ    $0x08, DX
+	SHLQ    $0x04, DX
+	ADDQ    CX, DX
+	MOVOU   (DX), X1
+	MOVQ    in_base+0(FP), CX
+	MOVQ    CX, DX
+	MOVQ    lenTable+64(FP), BX
+	MOVBQZX AL, AX
+	ADDQ    BX, AX
+	MOVBQZX (AX), AX
+	ADDQ    AX, DX
+	MOVOU   (CX), X2
+	MOVOU   (DX), X3
+	PSHUFB  X0, X2
+	PSHUFB  X1, X3
+	MOVQ    out_base+24(FP), AX
+	MOVOU   X2, (AX)
+	MOVOU   X3, 16(AX)
+	RET
+
+// func Get8uint32DeltaSSEAsm(in []byte, out []uint32, ctrl uint16, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8)
+// Requires: SSE, SSE2, SSSE3
+TEXT ·Get8uint32DeltaSSEAsm(SB), NOSPLIT, $0-72
+	MOVWQZX ctrl+48(FP), AX
+	MOVQ    shuffle+56(FP), CX
+	MOVBQZX AL, DX
+	SHLQ    $0x04, DX
+	ADDQ    CX, DX
+	MOVOU   (DX), X0
+	MOVWQZX AX, DX
+	SHRQ    $0x08, DX
+	SHLQ    $0x04, DX
+	ADDQ    CX, DX
+	MOVOU   (DX), X1
+	MOVQ    in_base+0(FP), CX
+	MOVQ    CX, DX
+	MOVQ    lenTable+64(FP), BX
+	MOVBQZX AL, AX
+	ADDQ    BX, AX
+	MOVBQZX (AX), AX
+	ADDQ    AX, DX
+	MOVOU   (CX), X2
+	MOVOU   (DX), X3
+	PSHUFB  X0, X2
+	PSHUFB  X1, X3
+	MOVSS   prev+52(FP), X0
+	PSHUFD  $0x00, X0, X0
+	MOVOU   X2, X1
+	PSLLDQ  $0x04, X1
+	PADDL   X1, X2
+	MOVOU   X2, X1
+	PSLLDQ  $0x08, X1
+	PADDL   X0, X2
+	PADDL   X1, X2
+	PSHUFD  $0xff, X2, X0
+	MOVOU   X3, X1
+	PSLLDQ  $0x04, X1
+	PADDL   X1, X3
+	MOVOU   X3, X1
+	PSLLDQ  $0x08, X1
+	PADDL   X0, X3
+	PADDL   X1, X3
+	MOVQ    out_base+24(FP), AX
+	MOVOU   X2, (AX)
+	MOVOU   X3, 16(AX)
+	RET
+
+// func GetNuint32FastAsm(in []byte, out []uint32, ctrl uint8, count int, shuffle *[256][16]uint8, lenTable *[256]uint8) (n int)
+// Requires: AVX
+TEXT ·GetNuint32FastAsm(SB), NOSPLIT, $0-88
+	MOVQ    count+56(FP), AX
+	MOVBQZX ctrl+48(FP), CX
+	LEAQ    ctrlMask<>+0(SB), DX
+	MOVBQZX (DX)(AX*1), DX
+	ANDQ    DX, CX
+	MOVQ    lenTable+72(FP), DX
+	MOVBQZX CL, BX
+	ADDQ    DX, BX
+	MOVBQZX (BX), BX
+	ADDQ    AX, BX
+	SUBQ    $0x04, BX
+	MOVQ    BX, n+80(FP)
+	MOVQ    in_base+0(FP), DX
+	CMPQ    BX, $0x10
+	JE      load_full
+	XORQ    SI, SI
+	XORQ    DI, DI
+	MOVQ    BX, R8
+	ANDQ    $0x08, R8
+	ADDQ    DX, R8
+	TESTQ   $0x00000001, BX
+	JE      load_skip1
+	MOVQ    BX, R9
+	ANDQ    $0x06, R9
+	MOVBQZX (R8)(R9*1), DI
+
+load_skip1:
+	TESTQ   $0x00000002, BX
+	JE      load_skip2
+	MOVQ    BX, R9
+	ANDQ    $0x04, R9
+	MOVWQZX (R8)(R9*1), R9
+	SHLQ    $0x10, DI
+	ORQ     R9, DI
+
+load_skip2:
+	TESTQ $0x00000004, BX
+	JE    load_skip4
+	MOVL  (R8), R9
+	SHLQ  $0x20, DI
+	ORQ   R9, DI
+
+load_skip4:
+	TESTQ $0x00000008, BX
+	JE    load_low
+	MOVQ  (DX), DX
+	MOVQ  DI, SI
+	JMP   load_insert
+
+load_low:
+	MOVQ DI, DX
+
+load_insert:
+	VMOVQ   DX, X0
+	VPINSRQ $0x01, SI, X0, X0
+	JMP     load_done
+
+load_full:
+	VMOVDQU (DX), X0
+
+load_done:
+	MOVQ       shuffle+64(FP), DX
+	MOVBQZX    CL, CX
+	SHLQ       $0x04, CX
+	ADDQ       DX, CX
+	VPSHUFB    (CX), X0, X0
+	LEAQ       countMask<>+0(SB), CX
+	SHLQ       $0x04, AX
+	VMOVDQU    (CX)(AX*1), X1
+	MOVQ       out_base+24(FP), AX
+	VMASKMOVPS X0, X1, (AX)
+	RET
+
+// func GetNuint32DeltaFastAsm(in []byte, out []uint32, ctrl uint8, count int, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8) (n int)
+// Requires: AVX
+TEXT ·GetNuint32DeltaFastAsm(SB), NOSPLIT, $0-96
+	MOVQ    count+56(FP), AX
+	MOVBQZX ctrl+48(FP), CX
+	LEAQ    ctrlMask<>+0(SB), DX
+	MOVBQZX (DX)(AX*1), DX
+	ANDQ    DX, CX
+	MOVQ    lenTable+80(FP), DX
+	MOVBQZX CL, BX
+	ADDQ    DX, BX
+	MOVBQZX (BX), BX
+	ADDQ    AX, BX
+	SUBQ    $0x04, BX
+	MOVQ    BX, n+88(FP)
+	MOVQ    in_base+0(FP), DX
+	CMPQ    BX, $0x10
+	JE      load_full
+	XORQ    SI, SI
+	XORQ    DI, DI
+	MOVQ    BX, R8
+	ANDQ    $0x08, R8
+	ADDQ    DX, R8
+	TESTQ   $0x00000001, BX
+	JE      load_skip1
+	MOVQ    BX, R9
+	ANDQ    $0x06, R9
+	MOVBQZX (R8)(R9*1), DI
+
+load_skip1:
+	TESTQ   $0x00000002, BX
+	JE      load_skip2
+	MOVQ    BX, R9
+	ANDQ    $0x04, R9
+	MOVWQZX (R8)(R9*1), R9
+	SHLQ    $0x10, DI
+	ORQ     R9, DI
+
+load_skip2:
+	TESTQ $0x00000004, BX
+	JE    load_skip4
+	MOVL  (R8), R9
+	SHLQ  $0x20, DI
+	ORQ   R9, DI
+
+load_skip4:
+	TESTQ $0x00000008, BX
+	JE    load_low
+	MOVQ  (DX), DX
+	MOVQ  DI, SI
+	JMP   load_insert
+
+load_low:
+	MOVQ DI, DX
+
+load_insert:
+	VMOVQ   DX, X0
+	VPINSRQ $0x01, SI, X0, X0
+	JMP     load_done
+
+load_full:
+	VMOVDQU (DX), X0
+
+load_done:
+	MOVQ         shuffle+72(FP), DX
+	MOVBQZX      CL, CX
+	SHLQ         $0x04, CX
+	ADDQ         DX, CX
+	VPSHUFB      (CX), X0, X0
+	LEAQ         countMask<>+0(SB), CX
+	SHLQ         $0x04, AX
+	VMOVDQU      (CX)(AX*1), X1
+	VBROADCASTSS prev+64(FP), X2
+	VPSLLDQ      $0x04, X0, X3
+	VPADDD       X0, X3, X0
+	VPSLLDQ      $0x08, X0, X3
+	VPADDD       X0, X2, X0
+	VPADDD       X0, X3, X0
+	MOVQ         out_base+24(FP), AX
+	VMASKMOVPS   X0, X1, (AX)
+	RET
+
+// func SumUint32FastAsm(ctrls []byte, data []byte, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint64, n int)
+// Requires: AVX
+TEXT ·SumUint32FastAsm(SB), NOSPLIT, $0-80
+	MOVQ  ctrls_base+0(FP), AX
+	MOVQ  ctrls_len+8(FP), CX
+	ADDQ  AX, CX
+	MOVQ  data_base+24(FP), DX
+	MOVQ  DX, BX
+	MOVQ  shuffle+48(FP), SI
+	MOVQ  lenTable+56(FP), DI
+	VPXOR X0, X0, X0
+
+SumUint32FastAsm_loop:
+	CMPQ       AX, CX
+	JAE        SumUint32FastAsm_done
+	MOVWQZX    (AX), R8
+	MOVBQZX    R8, R9
+	SHLQ       $0x04, R9
+	ADDQ       SI, R9
+	MOVWQZX    R8, R10
+	SHRQ       $0x08, R10
+	SHLQ       $0x04, R10
+	ADDQ       SI, R10
+	MOVBQZX    R8, R11
+	MOVBQZX    (DI)(R11*1), R11
+	MOVWQZX    R8, R8
+	SHRQ       $0x08, R8
+	MOVBQZX    (DI)(R8*1), R8
+	VLDDQU     (BX), X1
+	VLDDQU     (BX)(R11*1), X2
+	VPSHUFB    (R9), X1, X1
+	VPSHUFB    (R10), X2, X2
+	VPXOR      X3, X3, X3
+	VPUNPCKLDQ X3, X1, X4
+	VPADDQ     X4, X0, X0
+	VPUNPCKHDQ X3, X1, X4
+	VPADDQ     X4, X0, X0
+	VPXOR      X1, X1, X1
+	VPUNPCKLDQ X1, X2, X3
+	VPADDQ     X3, X0, X0
+	VPUNPCKHDQ X1, X2, X3
+	VPADDQ     X3, X0, X0
+	ADDQ       R11, BX
+	ADDQ       R8, BX
+	ADDQ       $0x02, AX
+	JMP        SumUint32FastAsm_loop
+
+SumUint32FastAsm_done:
+	VPSHUFD $0x4e, X0, X1
+	VPADDQ  X1, X0, X0
+	VMOVQ   X0, AX
+	MOVQ    AX, r+64(FP)
+	SUBQ    DX, BX
+	MOVQ    BX, n+72(FP)
+	RET
+
+// func SumUint32DeltaFastAsm(ctrls []byte, data []byte, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint64, last uint32, n int)
+// Requires: AVX
+TEXT ·SumUint32DeltaFastAsm(SB), NOSPLIT, $0-96
+	MOVQ         ctrls_base+0(FP), AX
+	MOVQ         ctrls_len+8(FP), CX
+	ADDQ         AX, CX
+	MOVQ         data_base+24(FP), DX
+	MOVQ         DX, BX
+	MOVQ         shuffle+56(FP), SI
+	MOVQ         lenTable+64(FP), DI
+	VPXOR        X0, X0, X0
+	VBROADCASTSS prev+48(FP), X1
+
+SumUint32DeltaFastAsm_loop:
+	CMPQ       AX, CX
+	JAE        SumUint32DeltaFastAsm_done
+	MOVWQZX    (AX), R8
+	MOVBQZX    R8, R9
+	SHLQ       $0x04, R9
+	ADDQ       SI, R9
+	MOVWQZX    R8, R10
+	SHRQ       $0x08, R10
+	SHLQ       $0x04, R10
+	ADDQ       SI, R10
+	MOVBQZX    R8, R11
+	MOVBQZX    (DI)(R11*1), R11
+	MOVWQZX    R8, R8
+	SHRQ       $0x08, R8
+	MOVBQZX    (DI)(R8*1), R8
+	VLDDQU     (BX), X2
+	VLDDQU     (BX)(R11*1), X3
+	VPSHUFB    (R9), X2, X2
+	VPSHUFB    (R10), X3, X3
+	VPSLLDQ    $0x04, X2, X4
+	VPADDD     X2, X4, X2
+	VPSLLDQ    $0x08, X2, X4
+	VPADDD     X2, X1, X2
+	VPADDD     X2, X4, X2
+	VPSHUFD    $0xff, X2, X1
+	VPSLLDQ    $0x04, X3, X4
+	VPADDD     X3, X4, X3
+	VPSLLDQ    $0x08, X3, X4
+	VPADDD     X3, X1, X3
+	VPADDD     X3, X4, X3
+	VPSHUFD    $0xff, X3, X1
+	VPXOR      X4, X4, X4
+	VPUNPCKLDQ X4, X2, X5
+	VPADDQ     X5, X0, X0
+	VPUNPCKHDQ X4, X2, X5
+	VPADDQ     X5, X0, X0
+	VPXOR      X2, X2, X2
+	VPUNPCKLDQ X2, X3, X4
+	VPADDQ     X4, X0, X0
+	VPUNPCKHDQ X2, X3, X4
+	VPADDQ     X4, X0, X0
+	ADDQ       R11, BX
+	ADDQ       R8, BX
+	ADDQ       $0x02, AX
+	JMP        SumUint32DeltaFastAsm_loop
+
+SumUint32DeltaFastAsm_done:
+	VPSHUFD $0x4e, X0, X2
+	VPADDQ  X2, X0, X0
+	VMOVQ   X0, AX
+	MOVQ    AX, r+72(FP)
+	VMOVD   X1, AX
+	MOVL    AX, last+80(FP)
+	SUBQ    DX, BX
+	MOVQ    BX, n+88(FP)
+	RET
+
+// func MinUint32FastAsm(ctrls []byte, data []byte, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint32, n int)
+// Requires: AVX
+TEXT ·MinUint32FastAsm(SB), NOSPLIT, $0-80
+	MOVQ     ctrls_base+0(FP), AX
+	MOVQ     ctrls_len+8(FP), CX
+	ADDQ     AX, CX
+	MOVQ     data_base+24(FP), DX
+	MOVQ     DX, BX
+	MOVQ     shuffle+48(FP), SI
+	MOVQ     lenTable+56(FP), DI
+	VPCMPEQD X0, X0, X0
+
+MinUint32FastAsm_loop:
+	CMPQ    AX, CX
+	JAE     MinUint32FastAsm_done
+	MOVWQZX (AX), R8
+	MOVBQZX R8, R9
+	SHLQ    $0x04, R9
+	ADDQ    SI, R9
+	MOVWQZX R8, R10
+	SHRQ    $0x08, R10
+	SHLQ    $0x04, R10
+	ADDQ    SI, R10
+	MOVBQZX R8, R11
+	MOVBQZX (DI)(R11*1), R11
+	MOVWQZX R8, R8
+	SHRQ    $0x08, R8
+	MOVBQZX (DI)(R8*1), R8
+	VLDDQU  (BX), X1
+	VLDDQU  (BX)(R11*1), X2
+	VPSHUFB (R9), X1, X1
+	VPSHUFB (R10), X2, X2
+	VPMINUD X1, X0, X0
+	VPMINUD X2, X0, X0
+	ADDQ    R11, BX
+	ADDQ    R8, BX
+	ADDQ    $0x02, AX
+	JMP     MinUint32FastAsm_loop
+
+MinUint32FastAsm_done:
+	VPSHUFD $0x4e, X0, X1
+	VPMINUD X1, X0, X0
+	VPSHUFD $0xb1, X0, X1
+	VPMINUD X1, X0, X0
+	VMOVD   X0, AX
+	MOVL    AX, r+64(FP)
+	SUBQ    DX, BX
+	MOVQ    BX, n+72(FP)
+	RET
+
+// func MinUint32DeltaFastAsm(ctrls []byte, data []byte, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint32, last uint32, n int)
+// Requires: AVX
+TEXT ·MinUint32DeltaFastAsm(SB), NOSPLIT, $0-88
+	MOVQ         ctrls_base+0(FP), AX
+	MOVQ         ctrls_len+8(FP), CX
+	ADDQ         AX, CX
+	MOVQ         data_base+24(FP), DX
+	MOVQ         DX, BX
+	MOVQ         shuffle+56(FP), SI
+	MOVQ         lenTable+64(FP), DI
+	VPCMPEQD     X0, X0, X0
+	VBROADCASTSS prev+48(FP), X1
+
+MinUint32DeltaFastAsm_loop:
+	CMPQ    AX, CX
+	JAE     MinUint32DeltaFastAsm_done
+	MOVWQZX (AX), R8
+	MOVBQZX R8, R9
+	SHLQ    $0x04, R9
+	ADDQ    SI, R9
+	MOVWQZX R8, R10
+	SHRQ    $0x08, R10
+	SHLQ    $0x04, R10
+	ADDQ    SI, R10
+	MOVBQZX R8, R11
+	MOVBQZX (DI)(R11*1), R11
+	MOVWQZX R8, R8
+	SHRQ    $0x08, R8
+	MOVBQZX (DI)(R8*1), R8
+	VLDDQU  (BX), X2
+	VLDDQU  (BX)(R11*1), X3
+	VPSHUFB (R9), X2, X2
+	VPSHUFB (R10), X3, X3
+	VPSLLDQ $0x04, X2, X4
+	VPADDD  X2, X4, X2
+	VPSLLDQ $0x08, X2, X4
+	VPADDD  X2, X1, X2
+	VPADDD  X2, X4, X2
+	VPSHUFD $0xff, X2, X1
+	VPSLLDQ $0x04, X3, X4
+	VPADDD  X3, X4, X3
+	VPSLLDQ $0x08, X3, X4
+	VPADDD  X3, X1, X3
+	VPADDD  X3, X4, X3
+	VPSHUFD $0xff, X3, X1
+	VPMINUD X2, X0, X0
+	VPMINUD X3, X0, X0
+	ADDQ    R11, BX
+	ADDQ    R8, BX
+	ADDQ    $0x02, AX
+	JMP     MinUint32DeltaFastAsm_loop
+
+MinUint32DeltaFastAsm_done:
+	VPSHUFD $0x4e, X0, X2
+	VPMINUD X2, X0, X0
+	VPSHUFD $0xb1, X0, X2
+	VPMINUD X2, X0, X0
+	VMOVD   X0, AX
+	MOVL    AX, r+72(FP)
+	VMOVD   X1, AX
+	MOVL    AX, last+76(FP)
+	SUBQ    DX, BX
+	MOVQ    BX, n+80(FP)
+	RET
+
+// func MaxUint32FastAsm(ctrls []byte, data []byte, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint32, n int)
+// Requires: AVX
+TEXT ·MaxUint32FastAsm(SB), NOSPLIT, $0-80
+	MOVQ  ctrls_base+0(FP), AX
+	MOVQ  ctrls_len+8(FP), CX
+	ADDQ  AX, CX
+	MOVQ  data_base+24(FP), DX
+	MOVQ  DX, BX
+	MOVQ  shuffle+48(FP), SI
+	MOVQ  lenTable+56(FP), DI
+	VPXOR X0, X0, X0
+
+MaxUint32FastAsm_loop:
+	CMPQ    AX, CX
+	JAE     MaxUint32FastAsm_done
+	MOVWQZX (AX), R8
+	MOVBQZX R8, R9
+	SHLQ    $0x04, R9
+	ADDQ    SI, R9
+	MOVWQZX R8, R10
+	SHRQ    $0x08, R10
+	SHLQ    $0x04, R10
+	ADDQ    SI, R10
+	MOVBQZX R8, R11
+	MOVBQZX (DI)(R11*1), R11
+	MOVWQZX R8, R8
+	SHRQ    $0x08, R8
+	MOVBQZX (DI)(R8*1), R8
+	VLDDQU  (BX), X1
+	VLDDQU  (BX)(R11*1), X2
+	VPSHUFB (R9), X1, X1
+	VPSHUFB (R10), X2, X2
+	VPMAXUD X1, X0, X0
+	VPMAXUD X2, X0, X0
+	ADDQ    R11, BX
+	ADDQ    R8, BX
+	ADDQ    $0x02, AX
+	JMP     MaxUint32FastAsm_loop
+
+MaxUint32FastAsm_done:
+	VPSHUFD $0x4e, X0, X1
+	VPMAXUD X1, X0, X0
+	VPSHUFD $0xb1, X0, X1
+	VPMAXUD X1, X0, X0
+	VMOVD   X0, AX
+	MOVL    AX, r+64(FP)
+	SUBQ    DX, BX
+	MOVQ    BX, n+72(FP)
+	RET
+
+// func MaxUint32DeltaFastAsm(ctrls []byte, data []byte, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint32, last uint32, n int)
+// Requires: AVX
+TEXT ·MaxUint32DeltaFastAsm(SB), NOSPLIT, $0-88
+	MOVQ         ctrls_base+0(FP), AX
+	MOVQ         ctrls_len+8(FP), CX
+	ADDQ         AX, CX
+	MOVQ         data_base+24(FP), DX
+	MOVQ         DX, BX
+	MOVQ         shuffle+56(FP), SI
+	MOVQ         lenTable+64(FP), DI
+	VPXOR        X0, X0, X0
+	VBROADCASTSS prev+48(FP), X1
+
+MaxUint32DeltaFastAsm_loop:
+	CMPQ    AX, CX
+	JAE     MaxUint32DeltaFastAsm_done
+	MOVWQZX (AX), R8
+	MOVBQZX R8, R9
+	SHLQ    $0x04, R9
+	ADDQ    SI, R9
+	MOVWQZX R8, R10
+	SHRQ    $0x08, R10
+	SHLQ    $0x04, R10
+	ADDQ    SI, R10
+	MOVBQZX R8, R11
+	MOVBQZX (DI)(R11*1), R11
+	MOVWQZX R8, R8
+	SHRQ    $0x08, R8
+	MOVBQZX (DI)(R8*1), R8
+	VLDDQU  (BX), X2
+	VLDDQU  (BX)(R11*1), X3
+	VPSHUFB (R9), X2, X2
+	VPSHUFB (R10), X3, X3
+	VPSLLDQ $0x04, X2, X4
+	VPADDD  X2, X4, X2
+	VPSLLDQ $0x08, X2, X4
+	VPADDD  X2, X1, X2
+	VPADDD  X2, X4, X2
+	VPSHUFD $0xff, X2, X1
+	VPSLLDQ $0x04, X3, X4
+	VPADDD  X3, X4, X3
+	VPSLLDQ $0x08, X3, X4
+	VPADDD  X3, X1, X3
+	VPADDD  X3, X4, X3
+	VPSHUFD $0xff, X3, X1
+	VPMAXUD X2, X0, X0
+	VPMAXUD X3, X0, X0
+	ADDQ    R11, BX
+	ADDQ    R8, BX
+	ADDQ    $0x02, AX
+	JMP     MaxUint32DeltaFastAsm_loop
+
+MaxUint32DeltaFastAsm_done:
+	VPSHUFD $0x4e, X0, X2
+	VPMAXUD X2, X0, X0
+	VPSHUFD $0xb1, X0, X2
+	VPMAXUD X2, X0, X0
+	VMOVD   X0, AX
+	MOVL    AX, r+72(FP)
+	VMOVD   X1, AX
+	MOVL    AX, last+76(FP)
+	SUBQ    DX, BX
+	MOVQ    BX, n+80(FP)
+	RET
+
+// func XorUint32FastAsm(ctrls []byte, data []byte, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint32, n int)
+// Requires: AVX
+TEXT ·XorUint32FastAsm(SB), NOSPLIT, $0-80
+	MOVQ  ctrls_base+0(FP), AX
+	MOVQ  ctrls_len+8(FP), CX
+	ADDQ  AX, CX
+	MOVQ  data_base+24(FP), DX
+	MOVQ  DX, BX
+	MOVQ  shuffle+48(FP), SI
+	MOVQ  lenTable+56(FP), DI
+	VPXOR X0, X0, X0
+
+XorUint32FastAsm_loop:
+	CMPQ    AX, CX
+	JAE     XorUint32FastAsm_done
+	MOVWQZX (AX), R8
+	MOVBQZX R8, R9
+	SHLQ    $0x04, R9
+	ADDQ    SI, R9
+	MOVWQZX R8, R10
+	SHRQ    $0x08, R10
+	SHLQ    $0x04, R10
+	ADDQ    SI, R10
+	MOVBQZX R8, R11
+	MOVBQZX (DI)(R11*1), R11
+	MOVWQZX R8, R8
+	SHRQ    $0x08, R8
+	MOVBQZX (DI)(R8*1), R8
+	VLDDQU  (BX), X1
+	VLDDQU  (BX)(R11*1), X2
+	VPSHUFB (R9), X1, X1
+	VPSHUFB (R10), X2, X2
+	VPXOR   X1, X0, X0
+	VPXOR   X2, X0, X0
+	ADDQ    R11, BX
+	ADDQ    R8, BX
+	ADDQ    $0x02, AX
+	JMP     XorUint32FastAsm_loop
+
+XorUint32FastAsm_done:
+	VPSHUFD $0x4e, X0, X1
+	VPXOR   X1, X0, X0
+	VPSHUFD $0xb1, X0, X1
+	VPXOR   X1, X0, X0
+	VMOVD   X0, AX
+	MOVL    AX, r+64(FP)
+	SUBQ    DX, BX
+	MOVQ    BX, n+72(FP)
+	RET
+
+// func XorUint32DeltaFastAsm(ctrls []byte, data []byte, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint32, last uint32, n int)
+// Requires: AVX
+TEXT ·XorUint32DeltaFastAsm(SB), NOSPLIT, $0-88
+	MOVQ         ctrls_base+0(FP), AX
+	MOVQ         ctrls_len+8(FP), CX
+	ADDQ         AX, CX
+	MOVQ         data_base+24(FP), DX
+	MOVQ         DX, BX
+	MOVQ         shuffle+56(FP), SI
+	MOVQ         lenTable+64(FP), DI
+	VPXOR        X0, X0, X0
+	VBROADCASTSS prev+48(FP), X1
+
+XorUint32DeltaFastAsm_loop:
+	CMPQ    AX, CX
+	JAE     XorUint32DeltaFastAsm_done
+	MOVWQZX (AX), R8
+	MOVBQZX R8, R9
+	SHLQ    $0x04, R9
+	ADDQ    SI, R9
+	MOVWQZX R8, R10
+	SHRQ    $0x08, R10
+	SHLQ    $0x04, R10
+	ADDQ    SI, R10
+	MOVBQZX R8, R11
+	MOVBQZX (DI)(R11*1), R11
+	MOVWQZX R8, R8
+	SHRQ    $0x08, R8
+	MOVBQZX (DI)(R8*1), R8
+	VLDDQU  (BX), X2
+	VLDDQU  (BX)(R11*1), X3
+	VPSHUFB (R9), X2, X2
+	VPSHUFB (R10), X3, X3
+	VPSLLDQ $0x04, X2, X4
+	VPADDD  X2, X4, X2
+	VPSLLDQ $0x08, X2, X4
+	VPADDD  X2, X1, X2
+	VPADDD  X2, X4, X2
+	VPSHUFD $0xff, X2, X1
+	VPSLLDQ $0x04, X3, X4
+	VPADDD  X3, X4, X3
+	VPSLLDQ $0x08, X3, X4
+	VPADDD  X3, X1, X3
+	VPADDD  X3, X4, X3
+	VPSHUFD $0xff, X3, X1
+	VPXOR   X2, X0, X0
+	VPXOR   X3, X0, X0
+	ADDQ    R11, BX
+	ADDQ    R8, BX
+	ADDQ    $0x02, AX
+	JMP     XorUint32DeltaFastAsm_loop
+
+XorUint32DeltaFastAsm_done:
+	VPSHUFD $0x4e, X0, X2
+	VPXOR   X2, X0, X0
+	VPSHUFD $0xb1, X0, X2
+	VPXOR   X2, X0, X0
+	VMOVD   X0, AX
+	MOVL    AX, r+72(FP)
+	VMOVD   X1, AX
+	MOVL    AX, last+76(FP)
+	SUBQ    DX, BX
+	MOVQ    BX, n+80(FP)
+	RET
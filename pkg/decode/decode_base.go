@@ -10,6 +10,20 @@ func GetMode() shared.PerformanceMode {
 	return shared.Normal
 }
 
+func detectTier() shared.Tier {
+	return shared.TierScalar
+}
+
+func defineFastOps() {}
+
+func selfTestKernels() []selfTestKernel {
+	return nil
+}
+
+func ControlLenFast(ctrls []byte) int {
+	panic("unreachable")
+}
+
 func Get8uint32Fast(in []byte, out []uint32, ctrl uint16) int {
 	panic("unreachable")
 }
@@ -17,3 +31,79 @@ func Get8uint32Fast(in []byte, out []uint32, ctrl uint16) int {
 func Get8uint32DeltaFast(in []byte, out []uint32, ctrl uint16, prev uint32) int {
 	panic("unreachable")
 }
+
+func Get8int32Fast(in []byte, out []int32, ctrl uint16) {
+	panic("unreachable")
+}
+
+func Get8uint64Fast(in []byte, out []uint64, ctrl uint16) {
+	panic("unreachable")
+}
+
+func Get8uint64DeltaFast(in []byte, out []uint64, ctrl uint16, prev uint64) {
+	panic("unreachable")
+}
+
+func Get8uint16Fast(in []byte, out []uint16, ctrl uint8) {
+	panic("unreachable")
+}
+
+func Get8uint16DeltaFast(in []byte, out []uint16, ctrl uint8, prev uint16) {
+	panic("unreachable")
+}
+
+func Get8uint32Fast0124(in []byte, out []uint32, ctrl uint16) {
+	panic("unreachable")
+}
+
+func Get8uint32DeltaFast0124(in []byte, out []uint32, ctrl uint16, prev uint32) {
+	panic("unreachable")
+}
+
+func SumUint32Fast(ctrls, data []byte) (uint64, int) {
+	panic("unreachable")
+}
+
+func SumUint32DeltaFast(ctrls, data []byte, prev uint32) (uint64, uint32, int) {
+	panic("unreachable")
+}
+
+func MinUint32Fast(ctrls, data []byte) (uint32, int) {
+	panic("unreachable")
+}
+
+func MinUint32DeltaFast(ctrls, data []byte, prev uint32) (uint32, uint32, int) {
+	panic("unreachable")
+}
+
+func MaxUint32Fast(ctrls, data []byte) (uint32, int) {
+	panic("unreachable")
+}
+
+func MaxUint32DeltaFast(ctrls, data []byte, prev uint32) (uint32, uint32, int) {
+	panic("unreachable")
+}
+
+func XorUint32Fast(ctrls, data []byte) (uint32, int) {
+	panic("unreachable")
+}
+
+func XorUint32DeltaFast(ctrls, data []byte, prev uint32) (uint32, uint32, int) {
+	panic("unreachable")
+}
+
+func Get8uint32SSE(in []byte, out []uint32, ctrl uint16) {
+	panic("unreachable")
+}
+
+func Get8uint32DeltaSSE(in []byte, out []uint32, ctrl uint16, prev uint32) {
+	panic("unreachable")
+}
+
+func GetNuint32Fast(in []byte, out []uint32, ctrl uint8, count int) int {
+	panic("unreachable")
+}
+
+func GetNuint32DeltaFast(in []byte, out []uint32, ctrl uint8, count int, prev uint32) int {
+	panic("unreachable")
+}
@@ -3,7 +3,10 @@ package decode
 import (
 	"encoding/binary"
 	"math/rand"
+	"os"
+	"os/exec"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -78,6 +81,320 @@ func TestGet8uint32DeltaFast(t *testing.T) {
 	}
 }
 
+func TestGet8uint32SSE(t *testing.T) {
+	if detectTier() < shared.TierSSE41 {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenUint32(count)
+	in := make([]byte, count*encode.MaxBytesPerNum)
+	ctrl := encode.Put8uint32Scalar(expected, in)
+	out := make([]uint32, 8)
+
+	Get8uint32SSE(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint32DeltaSSE(t *testing.T) {
+	if detectTier() < shared.TierSSE41 {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenUint32(count)
+	util.SortUint32(expected)
+	prev := expected[0] / 2
+	in := make([]byte, count*encode.MaxBytesPerNum)
+	ctrl := encode.Put8uint32DeltaScalar(expected, in, prev)
+	out := make([]uint32, 8)
+
+	Get8uint32DeltaSSE(in, out, ctrl, prev)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestSelfTest(t *testing.T) {
+	if os.Getenv("SELFTEST_FALLBACK") != "" {
+		// Running as the child process started below.
+		shared.Fallback("forced")
+		if GetTier() != shared.TierScalar || SupportedTier() != shared.TierScalar {
+			t.Fatalf("expected tier %v after fallback, got %v", shared.TierScalar, GetTier())
+		}
+		if detectTier() > shared.TierScalar && SetTier(detectTier()) == nil {
+			t.Fatalf("expected error setting tier %v after fallback", detectTier())
+		}
+		for _, impl := range shared.Implementations() {
+			if impl.Tier > shared.TierScalar && impl.Available && impl.Reason != "disabled: forced" {
+				t.Fatalf("unexpected reason for %s %s: %s", impl.Op, impl.Name, impl.Reason)
+			}
+		}
+		return
+	}
+
+	if err := SelfTest(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reason := shared.FallbackReason(); reason != "" {
+		t.Fatalf("unexpected fallback: %s", reason)
+	}
+
+	// Falling back is permanent, thus check it in a separate process,
+	// which also runs the self-test during initialization.
+	cmd := exec.Command(os.Args[0], "-test.run=^TestSelfTest$")
+	cmd.Env = append(os.Environ(), "SELFTEST_FALLBACK=1", shared.SelfTestEnv+"=1")
+	if out, err := cmd.CombinedOutput(); err != nil || !strings.Contains(string(out), "PASS") {
+		t.Fatalf("fallback check failed: %v\n%s", err, out)
+	}
+}
+
+func TestSetTier(t *testing.T) {
+	detected := detectTier()
+	defer SetTier(detected)
+	defer shared.SetMode(shared.GetMode())
+	shared.SetMode(shared.Fast)
+
+	if err := SetTier(detected + 1); err != shared.ErrUnsupportedTier {
+		t.Fatalf("expected %v, got %v", shared.ErrUnsupportedTier, err)
+	}
+
+	count := 8
+	expected := util.GenUint32(count)
+	in := make([]byte, count*encode.MaxBytesPerNum)
+	ctrl := encode.Put8uint32Scalar(expected, in)
+
+	for tier := shared.TierScalar; tier <= detected; tier++ {
+		if err := SetTier(tier); err != nil {
+			t.Fatalf("unexpected error setting tier %v: %v", tier, err)
+		}
+		if GetTier() != tier {
+			t.Fatalf("expected tier %v, got %v", tier, GetTier())
+		}
+
+		out := make([]uint32, count)
+		Get8uint32(in, out, ctrl)
+		if !reflect.DeepEqual(expected, out) {
+			t.Fatalf("tier %v: expected %+v, got %+v", tier, expected, out)
+		}
+	}
+}
+
+func TestGet8int32Scalar(t *testing.T) {
+	count := 8
+	expected := util.GenInt32(count)
+	in := make([]byte, count*encode.MaxBytesPerNum)
+	ctrl := encode.Put8int32Scalar(expected, in)
+	out := make([]int32, 8)
+
+	Get8int32Scalar(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8int32Fast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenInt32(count)
+	in := make([]byte, count*encode.MaxBytesPerNum)
+	ctrl := encode.Put8int32Scalar(expected, in)
+	out := make([]int32, 8)
+
+	Get8int32Fast(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint64Scalar(t *testing.T) {
+	count := 8
+	expected := util.GenUint64(count)
+	in := make([]byte, count*encode.MaxBytesPerNum64)
+	ctrl := encode.Put8uint64Scalar(expected, in)
+	out := make([]uint64, 8)
+
+	Get8uint64Scalar(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint64DeltaScalar(t *testing.T) {
+	count := 8
+	expected := util.GenUint64(count)
+	util.SortUint64(expected)
+	in := make([]byte, count*encode.MaxBytesPerNum64)
+	ctrl := encode.Put8uint64DeltaScalar(expected, in, 0)
+	out := make([]uint64, 8)
+
+	Get8uint64DeltaScalar(in, out, ctrl, 0)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint64Fast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenUint64(count)
+	in := make([]byte, count*encode.MaxBytesPerNum64)
+	ctrl := encode.Put8uint64Scalar(expected, in)
+	out := make([]uint64, 8)
+
+	Get8uint64Fast(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint64DeltaFast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenUint64(count)
+	util.SortUint64(expected)
+	prev := expected[0] / 2
+	in := make([]byte, count*encode.MaxBytesPerNum64)
+	ctrl := encode.Put8uint64DeltaScalar(expected, in, prev)
+	out := make([]uint64, 8)
+
+	Get8uint64DeltaFast(in, out, ctrl, prev)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint16Scalar(t *testing.T) {
+	count := 8
+	expected := util.GenUint16(count)
+	in := make([]byte, count*encode.MaxBytesPerNum16)
+	ctrl := encode.Put8uint16Scalar(expected, in)
+	out := make([]uint16, 8)
+
+	Get8uint16Scalar(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint16Fast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenUint16(count)
+	in := make([]byte, count*encode.MaxBytesPerNum16)
+	ctrl := encode.Put8uint16Scalar(expected, in)
+	out := make([]uint16, 8)
+
+	Get8uint16Fast(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint16DeltaFast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenUint16(count)
+	util.SortUint16(expected)
+	prev := expected[0] / 2
+	in := make([]byte, count*encode.MaxBytesPerNum16)
+	ctrl := encode.Put8uint16DeltaScalar(expected, in, prev)
+	out := make([]uint16, 8)
+
+	Get8uint16DeltaFast(in, out, ctrl, prev)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint32Scalar0124(t *testing.T) {
+	count := 8
+	expected := util.GenUint32(count)
+	expected[rand.Intn(count)] = 0
+	in := make([]byte, count*encode.MaxBytesPerNum)
+	ctrl := encode.Put8uint32Scalar0124(expected, in)
+	out := make([]uint32, 8)
+
+	Get8uint32Scalar0124(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint32Fast0124(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenUint32(count)
+	expected[rand.Intn(count)] = 0
+	in := make([]byte, count*encode.MaxBytesPerNum)
+	ctrl := encode.Put8uint32Scalar0124(expected, in)
+	out := make([]uint32, 8)
+
+	Get8uint32Fast0124(in, out, ctrl)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestGet8uint32DeltaFast0124(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	expected := util.GenUint32(count)
+	util.SortUint32(expected)
+	expected[1] = expected[0]
+	in := make([]byte, count*encode.MaxBytesPerNum)
+	ctrl := encode.Put8uint32DeltaScalar0124(expected, in, 0)
+	out := make([]uint32, 8)
+
+	Get8uint32DeltaFast0124(in, out, ctrl, 0)
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+}
+
+func TestControlLenFast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	for i := 0; i < 100; i++ {
+		ctrls := make([]byte, rand.Intn(1024))
+		rand.Read(ctrls)
+
+		expected := 0
+		for _, ctrl := range ctrls {
+			expected += int(shared.ControlByteToSize(ctrl))
+		}
+
+		if actual := ControlLenFast(ctrls); actual != expected {
+			t.Fatalf("expected %d, got %d for %d control bytes", expected, actual, len(ctrls))
+		}
+	}
+}
+
 func TestGetUint32Scalar(t *testing.T) {
 	count := rand.Intn(4) + 1
 	expected := util.GenUint32(count)
@@ -107,6 +424,66 @@ func TestGetUint32DeltaScalar(t *testing.T) {
 	}
 }
 
+func TestGetNuint32Fast(t *testing.T) {
+	if detectTier() < shared.TierAVX2 {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	for _, v := range shared.SelfTestVectors() {
+		for count := 1; count <= 4; count++ {
+			for _, delta := range []bool{false, true} {
+				testGetNuint32Fast(t, v.Nums[4-count:4], v.Prev, delta)
+			}
+		}
+	}
+}
+
+// testGetNuint32Fast decodes the encoding of expected, whose control has
+// the codes past len(expected) set, and makes sure that nothing is written
+// past the decoded integers.
+func testGetNuint32Fast(t *testing.T, expected []uint32, prev uint32, delta bool) {
+	t.Helper()
+
+	var (
+		count = len(expected)
+		in    = make([]byte, count*encode.MaxBytesPerNum)
+		ctrl  uint8
+		n     int
+	)
+	if delta {
+		ctrl = encode.PutUint32DeltaScalar(expected, in, count, prev)
+	} else {
+		ctrl = encode.PutUint32Scalar(expected, in, count)
+	}
+	size := shared.ControlByteToSize(ctrl) - (4 - count)
+	in = in[:size]
+	ctrl |= uint8(0xff << (2 * count))
+
+	out := make([]uint32, 5)
+	for i := range out {
+		out[i] = 0xa5a5a5a5
+	}
+	if delta {
+		n = GetNuint32DeltaFast(in, out, ctrl, count, prev)
+	} else {
+		n = GetNuint32Fast(in, out, ctrl, count)
+	}
+
+	if n != size {
+		t.Fatalf("expected %d bytes read, actual %d, %+v", size, n, expected)
+	}
+
+	if !reflect.DeepEqual(expected, out[:count]) {
+		t.Fatalf("expected %+v, got %+v", expected, out[:count])
+	}
+
+	for i, num := range out[count:] {
+		if num != 0xa5a5a5a5 {
+			t.Fatalf("integer %d past the decoded ones overwritten, %+v", count+i, expected)
+		}
+	}
+}
+
 var readSinkA []uint32
 
 func BenchmarkGet8uint32Fast(b *testing.B) {
@@ -0,0 +1,25 @@
+package decode
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// ControlLen returns the number of data bytes described by the provided
+// control bytes of a regular Stream VByte stream, i.e. the offset of the
+// data belonging to the control byte following them. It will use the
+// fastest implementation available determined during package
+// initialization.
+func ControlLen(ctrls []byte) int {
+	return ctrlLenImpl(ctrls)
+}
+
+// ControlLenScalar returns the number of data bytes described by the
+// provided control bytes by looking each of them up in
+// shared.PerControlLenTable.
+func ControlLenScalar(ctrls []byte) int {
+	total := 0
+	for _, ctrl := range ctrls {
+		total += int(shared.PerControlLenTable[ctrl])
+	}
+	return total
+}
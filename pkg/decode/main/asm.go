@@ -11,8 +11,18 @@ import (
 )
 
 const (
-	name      = "Get8uint32FastAsm"
-	nameDelta = "Get8uint32DeltaFastAsm"
+	name         = "Get8uint32FastAsm"
+	nameDelta    = "Get8uint32DeltaFastAsm"
+	nameZigzag   = "Get8int32FastAsm"
+	name64       = "Get8uint64FastAsm"
+	nameDelta64  = "Get8uint64DeltaFastAsm"
+	name16       = "Get8uint16FastAsm"
+	nameDelta16  = "Get8uint16DeltaFastAsm"
+	nameCtrlLen  = "ControlLenFastAsm"
+	nameSSE      = "Get8uint32SSEAsm"
+	nameDeltaSSE = "Get8uint32DeltaSSEAsm"
+	nameN        = "GetNuint32FastAsm"
+	nameDeltaN   = "GetNuint32DeltaFastAsm"
 
 	pIn       = "in"
 	pOut      = "out"
@@ -20,6 +30,12 @@ const (
 	pShuffle  = "shuffle"
 	pLenTable = "lenTable"
 	pPrev     = "prev"
+	pCtrls    = "ctrls"
+	pData     = "data"
+	pResult   = "r"
+	pLast     = "last"
+	pRead     = "n"
+	pCount    = "count"
 )
 
 var (
@@ -30,11 +46,58 @@ var (
 	signatureDelta = fmt.Sprintf(
 		"func(%s []byte, %s []uint32, %s uint16, %s uint32, %s *[256][16]uint8, %s *[256]uint8)",
 		pIn, pOut, pCtrl, pPrev, pShuffle, pLenTable)
+
+	signatureZigzag = fmt.Sprintf(
+		"func(%s []byte, %s []int32, %s uint16, %s *[256][16]uint8, %s *[256]uint8)",
+		pIn, pOut, pCtrl, pShuffle, pLenTable)
+
+	signature64 = fmt.Sprintf(
+		"func(%s []byte, %s []uint64, %s uint16, %s *[16][16]uint8, %s *[16]uint8)",
+		pIn, pOut, pCtrl, pShuffle, pLenTable)
+
+	signature16 = fmt.Sprintf(
+		"func(%s []byte, %s []uint16, %s uint8, %s *[256][16]uint8)",
+		pIn, pOut, pCtrl, pShuffle)
+
+	signatureDelta16 = fmt.Sprintf(
+		"func(%s []byte, %s []uint16, %s uint8, %s uint16, %s *[256][16]uint8)",
+		pIn, pOut, pCtrl, pPrev, pShuffle)
+
+	signatureN = fmt.Sprintf(
+		"func(%s []byte, %s []uint32, %s uint8, %s int, %s *[256][16]uint8, %s *[256]uint8) (%s int)",
+		pIn, pOut, pCtrl, pCount, pShuffle, pLenTable, pRead)
+
+	signatureDeltaN = fmt.Sprintf(
+		"func(%s []byte, %s []uint32, %s uint8, %s int, %s uint32, %s *[256][16]uint8, %s *[256]uint8) (%s int)",
+		pIn, pOut, pCtrl, pCount, pPrev, pShuffle, pLenTable, pRead)
+
+	countMaskR = shared.CountMaskTable("countMask")
+	ctrlMaskR  = ctrlMaskTable()
+
+	signatureCtrlLen = fmt.Sprintf("func(%s []byte) int", pCtrls)
+
+	signatureDelta64 = fmt.Sprintf(
+		"func(%s []byte, %s []uint64, %s uint16, %s uint64, %s *[16][16]uint8, %s *[16]uint8)",
+		pIn, pOut, pCtrl, pPrev, pShuffle, pLenTable)
 )
 
 func main() {
 	regular()
 	differential()
+	zigzag()
+	regular64()
+	differential64()
+	regular16()
+	differential16()
+	controlLen()
+	regularSSE()
+	differentialSSE()
+	regularN()
+	differentialN()
+	for _, op := range reduceOps {
+		reduce(op, false)
+		reduce(op, true)
+	}
 	Generate()
 }
 
@@ -74,6 +137,31 @@ func differential() {
 	RET()
 }
 
+func zigzag() {
+	TEXT(nameZigzag, NOSPLIT, signatureZigzag)
+
+	firstFour, secondFour := coreAlgorithm()
+	zigzagDecode(firstFour)
+	zigzagDecode(secondFour)
+
+	outBase := operand.Mem{Base: Load(Param(pOut).Base(), GP64())}
+
+	VMOVDQU(firstFour, outBase)
+	VMOVDQU(secondFour, outBase.Offset(16))
+
+	RET()
+}
+
+// zigzagDecode maps the zigzag encoded integers in four back onto
+// the original signed integers.
+func zigzagDecode(four reg.VecVirtual) {
+	sign := XMM()                       // [A B C D]
+	VPSLLD(operand.Imm(31), four, sign) // [A<<31 B<<31 C<<31 D<<31]
+	VPSRAD(operand.Imm(31), sign, sign) // [-(A&1) -(B&1) -(C&1) -(D&1)]
+	VPSRLD(operand.Imm(1), four, four)  // [A>>1 B>>1 C>>1 D>>1]
+	VPXOR(sign, four, four)             // [(A>>1)^-(A&1) ...]
+}
+
 func undoDelta(four, prev reg.VecVirtual) {
 	adder := XMM()                       // [A B C D]
 	VPSLLDQ(operand.Imm(4), four, adder) // [- A  B  C]
@@ -109,3 +197,512 @@ func coreAlgorithm() (reg.VecVirtual, reg.VecVirtual) {
 
 	return firstFour, secondFour
 }
+
+func regular64() {
+	TEXT(name64, NOSPLIT, signature64)
+
+	pairs := coreAlgorithm64()
+	outBase := operand.Mem{Base: Load(Param(pOut).Base(), GP64())}
+	for i, pair := range pairs {
+		VMOVDQU(pair, outBase.Offset(16*i))
+	}
+
+	RET()
+}
+
+func differential64() {
+	TEXT(nameDelta64, NOSPLIT, signatureDelta64)
+
+	pairs := coreAlgorithm64() // [A B] [C D] [E F] [G H]
+	prevSingular, err := Param(pPrev).Resolve()
+	if err != nil {
+		log.Fatalf("failed to get addr of prev")
+	}
+
+	prev := XMM()
+	VMOVQ(prevSingular.Addr, prev)
+	VPUNPCKLQDQ(prev, prev, prev) // [P P]
+
+	adder := XMM()
+	for _, pair := range pairs {
+		VPSLLDQ(operand.Imm(8), pair, adder) // [- A]
+		VPADDQ(pair, adder, pair)            // [A AB]
+		VPADDQ(pair, prev, pair)             // [PA PAB]
+		VPUNPCKHQDQ(pair, pair, prev)        // [PAB PAB]
+	}
+
+	outBase := operand.Mem{Base: Load(Param(pOut).Base(), GP64())}
+	for i, pair := range pairs {
+		VMOVDQU(pair, outBase.Offset(16*i))
+	}
+
+	RET()
+}
+
+// coreAlgorithm64 decodes the 8 uint64s described by the 16-bit control
+// into four registers each holding a pair of integers. Every 4-bit nibble
+// of the control describes a single pair.
+func coreAlgorithm64() [4]reg.VecVirtual {
+	ctrl := GP64()
+	Load(Param(pCtrl), ctrl)
+
+	shuffleBase := Load(Param(pShuffle), GP64())
+	lenBase := Load(Param(pLenTable), GP64())
+	inAddr := Load(Param(pIn).Base(), GP64())
+
+	var pairs [4]reg.VecVirtual
+	nibble := GP64()
+	lenValue := GP64()
+	for i := range pairs {
+		MOVQ(ctrl, nibble)
+		if i > 0 {
+			SHRQ(operand.Imm(uint64(4*i)), nibble)
+		}
+		ANDQ(operand.Imm(0xf), nibble)
+
+		pairs[i] = XMM()
+		VLDDQU(operand.Mem{Base: inAddr}, pairs[i])
+		if i < len(pairs)-1 {
+			MOVBQZX(operand.Mem{Base: lenBase, Index: nibble, Scale: 1}, lenValue)
+			ADDQ(lenValue, inAddr)
+		}
+
+		// Left shift by 4 to get the byte level offset for the shuffle table
+		SHLQ(operand.Imm(4), nibble)
+		VPSHUFB(operand.Mem{Base: shuffleBase, Index: nibble, Scale: 1}, pairs[i], pairs[i])
+	}
+
+	return pairs
+}
+
+func regular16() {
+	TEXT(name16, NOSPLIT, signature16)
+
+	eight := coreAlgorithm16()
+	VMOVDQU(eight, operand.Mem{Base: Load(Param(pOut).Base(), GP64())})
+
+	RET()
+}
+
+func differential16() {
+	TEXT(nameDelta16, NOSPLIT, signatureDelta16)
+
+	eight := coreAlgorithm16() // [A B C D E F G H]
+	prev := XMM()
+	VMOVD(Load(Param(pPrev), GP32()), prev) // [P - - - - - - -]
+	VPADDW(prev, eight, eight)              // [PA B C D E F G H]
+
+	adder := XMM()
+	for _, shift := range []uint64{2, 4, 8} {
+		VPSLLDQ(operand.Imm(shift), eight, adder) // [- PA B C D E F G]
+		VPADDW(adder, eight, eight)               // [PA PAB BC CD DE EF FG GH]
+	}
+
+	VMOVDQU(eight, operand.Mem{Base: Load(Param(pOut).Base(), GP64())})
+
+	RET()
+}
+
+// coreAlgorithm16 decodes the 8 uint16s described by the 8-bit control
+// into a single register.
+func coreAlgorithm16() reg.VecVirtual {
+	shuffle := GP64()
+	Load(Param(pCtrl), shuffle)
+
+	// Left shift by 4 to get the byte level offset for the shuffle table
+	SHLQ(operand.Imm(4), shuffle)
+	ADDQ(Load(Param(pShuffle), GP64()), shuffle)
+
+	eight := XMM()
+	VLDDQU(operand.Mem{Base: Load(Param(pIn).Base(), GP64())}, eight)
+	VPSHUFB(operand.Mem{Base: shuffle}, eight, eight)
+
+	return eight
+}
+
+// controlLen sums up the 2-bit codes of the control bytes 16 at a time.
+// Masking a control byte with 0x33 before and after shifting it right by
+// 2 bits leaves the sums of two codes in its nibbles, which are then added
+// up into bytes and accumulated with VPSADBW.
+func controlLen() {
+	TEXT(nameCtrlLen, NOSPLIT, signatureCtrlLen)
+
+	mask33R := ConstData("mask33", operand.U64(0x3333333333333333))
+	mask0FR := ConstData("mask0F", operand.U64(0x0f0f0f0f0f0f0f0f))
+
+	base := Load(Param(pCtrls).Base(), GP64())
+	blocks := Load(Param(pCtrls).Len(), GP64())
+	SHRQ(operand.Imm(4), blocks)
+
+	mask33, mask0F := XMM(), XMM()
+	VMOVDDUP(mask33R, mask33)
+	VMOVDDUP(mask0FR, mask0F)
+
+	zero, acc := XMM(), XMM()
+	VPXOR(zero, zero, zero)
+	VPXOR(acc, acc, acc)
+
+	Label("loop")
+	TESTQ(blocks, blocks)
+	JZ(operand.LabelRef("done"))
+
+	ctrls, codes := XMM(), XMM()
+	VMOVDQU(operand.Mem{Base: base}, ctrls)
+	VPSRLW(operand.Imm(2), ctrls, codes)
+	VPAND(mask33, ctrls, ctrls)
+	VPAND(mask33, codes, codes)
+	VPADDB(codes, ctrls, ctrls) // [c0+c1 | c2+c3<<4 ...]
+	VPSRLW(operand.Imm(4), ctrls, codes)
+	VPAND(mask0F, ctrls, ctrls)
+	VPAND(mask0F, codes, codes)
+	VPADDB(codes, ctrls, ctrls) // [c0+c1+c2+c3 ...]
+	VPSADBW(zero, ctrls, ctrls)
+	VPADDQ(ctrls, acc, acc)
+
+	ADDQ(operand.Imm(16), base)
+	DECQ(blocks)
+	JMP(operand.LabelRef("loop"))
+
+	Label("done")
+	high := XMM()
+	VPSHUFD(operand.Imm(0x4e), acc, high)
+	VPADDQ(high, acc, acc)
+
+	total := GP64()
+	VMOVQ(acc, total)
+	Store(total, ReturnIndex(0))
+	RET()
+}
+
+// reduceOp describes how a reduction kernel folds decoded integers into an
+// accumulator held in a vector register.
+type reduceOp struct {
+	name       string
+	resultType string
+	init       func(acc reg.VecVirtual)
+	fold       func(four, acc reg.VecVirtual)
+	finish     func(acc reg.VecVirtual) reg.Register
+}
+
+var reduceOps = []reduceOp{
+	{
+		// Sums are accumulated in two 64-bit lanes, thus the uint32s are
+		// zero extended before adding them up.
+		name:       "Sum",
+		resultType: "uint64",
+		init: func(acc reg.VecVirtual) {
+			VPXOR(acc, acc, acc)
+		},
+		fold: func(four, acc reg.VecVirtual) {
+			zero, wide := XMM(), XMM()
+			VPXOR(zero, zero, zero)
+			VPUNPCKLDQ(zero, four, wide) // [A - B -]
+			VPADDQ(wide, acc, acc)
+			VPUNPCKHDQ(zero, four, wide) // [C - D -]
+			VPADDQ(wide, acc, acc)
+		},
+		finish: func(acc reg.VecVirtual) reg.Register {
+			high := XMM()
+			VPSHUFD(operand.Imm(0x4e), acc, high)
+			VPADDQ(high, acc, acc)
+			r := GP64()
+			VMOVQ(acc, r)
+			return r
+		},
+	},
+	{
+		name:       "Min",
+		resultType: "uint32",
+		init: func(acc reg.VecVirtual) {
+			VPCMPEQD(acc, acc, acc)
+		},
+		fold: func(four, acc reg.VecVirtual) {
+			VPMINUD(four, acc, acc)
+		},
+		finish: func(acc reg.VecVirtual) reg.Register {
+			return horizontal32(acc, func(x, acc reg.VecVirtual) { VPMINUD(x, acc, acc) })
+		},
+	},
+	{
+		name:       "Max",
+		resultType: "uint32",
+		init: func(acc reg.VecVirtual) {
+			VPXOR(acc, acc, acc)
+		},
+		fold: func(four, acc reg.VecVirtual) {
+			VPMAXUD(four, acc, acc)
+		},
+		finish: func(acc reg.VecVirtual) reg.Register {
+			return horizontal32(acc, func(x, acc reg.VecVirtual) { VPMAXUD(x, acc, acc) })
+		},
+	},
+	{
+		name:       "Xor",
+		resultType: "uint32",
+		init: func(acc reg.VecVirtual) {
+			VPXOR(acc, acc, acc)
+		},
+		fold: func(four, acc reg.VecVirtual) {
+			VPXOR(four, acc, acc)
+		},
+		finish: func(acc reg.VecVirtual) reg.Register {
+			return horizontal32(acc, func(x, acc reg.VecVirtual) { VPXOR(x, acc, acc) })
+		},
+	},
+}
+
+// horizontal32 combines the four uint32 lanes of acc using op and returns
+// the result in a general purpose register.
+func horizontal32(acc reg.VecVirtual, op func(x, acc reg.VecVirtual)) reg.Register {
+	x := XMM()
+	VPSHUFD(operand.Imm(0x4e), acc, x) // [C D A B]
+	op(x, acc)
+	VPSHUFD(operand.Imm(0xb1), acc, x) // [B A D C]
+	op(x, acc)
+	r := GP32()
+	VMOVD(acc, r)
+	return r
+}
+
+// reduce generates a kernel that decodes the 8 uint32s described by every
+// pair of control bytes in ctrls and folds them into an accumulator using
+// op without ever writing them out to memory. Differentially coded
+// integers are reconstructed in-register, carrying the last integer over
+// from one batch to the next.
+func reduce(op reduceOp, delta bool) {
+	name := op.name + "Uint32FastAsm"
+	signature := fmt.Sprintf(
+		"func(%s []byte, %s []byte, %s *[256][16]uint8, %s *[256]uint8) (%s %s, %s int)",
+		pCtrls, pData, pShuffle, pLenTable, pResult, op.resultType, pRead)
+	if delta {
+		name = op.name + "Uint32DeltaFastAsm"
+		signature = fmt.Sprintf(
+			"func(%s []byte, %s []byte, %s uint32, %s *[256][16]uint8, %s *[256]uint8) (%s %s, %s uint32, %s int)",
+			pCtrls, pData, pPrev, pShuffle, pLenTable, pResult, op.resultType, pLast, pRead)
+	}
+	TEXT(name, NOSPLIT, signature)
+
+	ctrlPtr := Load(Param(pCtrls).Base(), GP64())
+	ctrlEnd := Load(Param(pCtrls).Len(), GP64())
+	ADDQ(ctrlPtr, ctrlEnd)
+	dataBase := Load(Param(pData).Base(), GP64())
+	dataPtr := GP64()
+	MOVQ(dataBase, dataPtr)
+	shuffleBase := Load(Param(pShuffle), GP64())
+	lenTableBase := Load(Param(pLenTable), GP64())
+
+	acc := XMM()
+	op.init(acc)
+
+	prev := XMM()
+	if delta {
+		prevSingular, err := Param(pPrev).Resolve()
+		if err != nil {
+			log.Fatalf("failed to get addr of prev")
+		}
+		VBROADCASTSS(prevSingular.Addr, prev) // [P P P P]
+	}
+
+	Label(name + "_loop")
+	CMPQ(ctrlPtr, ctrlEnd)
+	JAE(operand.LabelRef(name + "_done"))
+
+	ctrl := GP64()
+	MOVWQZX(operand.Mem{Base: ctrlPtr}, ctrl)
+	shuffleA := shared.CalculateShuffleAddrFromCtrl(shuffleBase, ctrl, false)
+	shuffleB := shared.CalculateShuffleAddrFromCtrl(shuffleBase, ctrl, true)
+
+	lowerSize, upperSize := GP64(), GP64()
+	MOVBQZX(ctrl.As8(), lowerSize)
+	MOVBQZX(operand.Mem{Base: lenTableBase, Index: lowerSize, Scale: 1}, lowerSize)
+	MOVWQZX(ctrl.As16(), upperSize)
+	SHRQ(operand.Imm(8), upperSize)
+	MOVBQZX(operand.Mem{Base: lenTableBase, Index: upperSize, Scale: 1}, upperSize)
+
+	firstFour, secondFour := XMM(), XMM()
+	VLDDQU(operand.Mem{Base: dataPtr}, firstFour)
+	VLDDQU(operand.Mem{Base: dataPtr, Index: lowerSize, Scale: 1}, secondFour)
+	VPSHUFB(shuffleA, firstFour, firstFour)
+	VPSHUFB(shuffleB, secondFour, secondFour)
+
+	if delta {
+		undoDelta(firstFour, prev)
+		VPSHUFD(operand.Imm(0xff), firstFour, prev) // [A B C D] -> [D D D D]
+		undoDelta(secondFour, prev)
+		VPSHUFD(operand.Imm(0xff), secondFour, prev)
+	}
+
+	op.fold(firstFour, acc)
+	op.fold(secondFour, acc)
+
+	ADDQ(lowerSize, dataPtr)
+	ADDQ(upperSize, dataPtr)
+	ADDQ(operand.Imm(2), ctrlPtr)
+	JMP(operand.LabelRef(name + "_loop"))
+
+	Label(name + "_done")
+	Store(op.finish(acc), Return(pResult))
+	if delta {
+		last := GP32()
+		VMOVD(prev, last)
+		Store(last, Return(pLast))
+	}
+	SUBQ(dataBase, dataPtr)
+	Store(dataPtr, Return(pRead))
+	RET()
+}
+
+// The SSE kernels below are legacy (non VEX encoded) counterparts of the
+// regular and differential kernels for CPUs without AVX. They only rely
+// on SSSE3 for PSHUFB. Legacy SSE instructions require their memory
+// operands to be aligned, thus everything is loaded into registers with
+// MOVOU first.
+
+func regularSSE() {
+	TEXT(nameSSE, NOSPLIT, signature)
+
+	firstFour, secondFour := coreAlgorithmSSE()
+	outBase := operand.Mem{Base: Load(Param(pOut).Base(), GP64())}
+
+	MOVOU(firstFour, outBase)
+	MOVOU(secondFour, outBase.Offset(16))
+
+	RET()
+}
+
+func differentialSSE() {
+	TEXT(nameDeltaSSE, NOSPLIT, signatureDelta)
+
+	firstFour, secondFour := coreAlgorithmSSE()
+	prevSingular, err := Param(pPrev).Resolve()
+	if err != nil {
+		log.Fatalf("failed to get addr of prev")
+	}
+
+	prev := XMM()
+	MOVSS(prevSingular.Addr, prev)
+	PSHUFD(operand.Imm(0), prev, prev) // [P P P P]
+	undoDeltaSSE(firstFour, prev)
+
+	PSHUFD(operand.Imm(0xff), firstFour, prev) // [A B C D] -> [D D D D]
+	undoDeltaSSE(secondFour, prev)
+
+	outBase := operand.Mem{Base: Load(Param(pOut).Base(), GP64())}
+
+	MOVOU(firstFour, outBase)
+	MOVOU(secondFour, outBase.Offset(16))
+
+	RET()
+}
+
+// undoDeltaSSE works similarly to undoDelta using legacy SSE
+// instructions.
+func undoDeltaSSE(four, prev reg.VecVirtual) {
+	adder := XMM()
+	MOVOU(four, adder)            // [A B C D]
+	PSLLDQ(operand.Imm(4), adder) // [- A  B  C]
+	PADDL(adder, four)            // [A AB BC CD]
+	MOVOU(four, adder)            // [A AB BC CD]
+	PSLLDQ(operand.Imm(8), adder) // [- - A AB]
+	PADDL(prev, four)             // [PA PAB PBC PCD]
+	PADDL(adder, four)            // [PA PAB PABC PABCD]
+}
+
+// coreAlgorithmSSE works similarly to coreAlgorithm using legacy SSE
+// instructions.
+func coreAlgorithmSSE() (reg.VecVirtual, reg.VecVirtual) {
+	ctrl := GP64()
+	Load(Param(pCtrl), ctrl)
+
+	shuffleBase := Load(Param(pShuffle), GP64())
+	shuffleA, shuffleB := XMM(), XMM()
+	MOVOU(shared.CalculateShuffleAddrFromCtrl(shuffleBase, ctrl, false), shuffleA)
+	MOVOU(shared.CalculateShuffleAddrFromCtrl(shuffleBase, ctrl, true), shuffleB)
+
+	firstBlock := Load(Param(pIn).Base(), GP64())
+	secondBlock := GP64()
+	MOVQ(firstBlock, secondBlock)
+	lowerAddr, lowerSize := shared.LenValueAddr(ctrl, false, pLenTable)
+
+	MOVBQZX(lowerAddr, lowerSize)
+	ADDQ(lowerSize, secondBlock)
+
+	firstFour := XMM()
+	secondFour := XMM()
+	MOVOU(operand.Mem{Base: firstBlock}, firstFour)
+	MOVOU(operand.Mem{Base: secondBlock}, secondFour)
+
+	PSHUFB(shuffleA, firstFour)
+	PSHUFB(shuffleB, secondFour)
+
+	return firstFour, secondFour
+}
+
+// The N kernels below decode the first count, 1 to 4, uint32s described
+// by an 8-bit control, e.g. the last ones of a stream. They neither read
+// past the data bytes of the count integers nor write past them.
+
+func regularN() {
+	TEXT(nameN, NOSPLIT, signatureN)
+
+	four, mask := coreAlgorithmN()
+	VMASKMOVPS(four, mask, operand.Mem{Base: Load(Param(pOut).Base(), GP64())})
+
+	RET()
+}
+
+func differentialN() {
+	TEXT(nameDeltaN, NOSPLIT, signatureDeltaN)
+
+	four, mask := coreAlgorithmN()
+	prevSingular, err := Param(pPrev).Resolve()
+	if err != nil {
+		log.Fatalf("failed to get addr of prev")
+	}
+
+	prev := XMM()
+	VBROADCASTSS(prevSingular.Addr, prev) // [P P P P]
+	undoDelta(four, prev)
+	VMASKMOVPS(four, mask, operand.Mem{Base: Load(Param(pOut).Base(), GP64())})
+
+	RET()
+}
+
+// ctrlMaskTable declares the masks selecting the 2-bit codes of the first
+// count integers of an 8-bit control, indexed by count.
+func ctrlMaskTable() operand.Mem {
+	table := GLOBL("ctrlMask", RODATA|NOPTR)
+	for i := 0; i <= 4; i++ {
+		DATA(i, operand.U8(1<<(2*i)-1))
+	}
+	return table
+}
+
+// coreAlgorithmN masks the 2-bit codes past count off the control so that
+// the data bytes of the count integers can be loaded without reading past
+// them, and shuffles them into place. It returns them along with the mask
+// selecting their lanes and stores the number of data bytes read.
+func coreAlgorithmN() (reg.VecVirtual, reg.VecVirtual) {
+	count := Load(Param(pCount), GP64())
+	ctrl := GP64()
+	Load(Param(pCtrl), ctrl)
+
+	maskBase := GP64()
+	ctrlMask := GP64()
+	LEAQ(ctrlMaskR, maskBase)
+	MOVBQZX(operand.Mem{Base: maskBase, Index: count, Scale: 1}, ctrlMask)
+	ANDQ(ctrlMask, ctrl)
+
+	// Every masked off code is counted as a single byte by the table
+	lenAddr, size := shared.LenValueAddr(ctrl, false, pLenTable)
+	MOVBQZX(lenAddr, size)
+	ADDQ(count, size)
+	SUBQ(operand.Imm(4), size)
+	Store(size, Return(pRead))
+
+	four := shared.LoadPartial(Load(Param(pIn).Base(), GP64()), size, "load")
+	shuffleBase := Load(Param(pShuffle), GP64())
+	VPSHUFB(shared.CalculateShuffleAddrFromCtrl(shuffleBase, ctrl, false), four, four)
+
+	return four, shared.LoadCountMask(countMaskR, count)
+}
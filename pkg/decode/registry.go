@@ -0,0 +1,40 @@
+package decode
+
+import "github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+
+// Ops of this package that kernels can be registered for using
+// shared.Register. The func of a kernel must have the same signature as the
+// scalar implementation of its op, e.g. Get8uint32Scalar for OpGet8uint32.
+const (
+	OpGet8uint32                 = "decode.Get8uint32"
+	OpGet8uint32Delta            = "decode.Get8uint32Delta"
+	OpGet8int32                  = "decode.Get8int32"
+	OpGet8uint64                 = "decode.Get8uint64"
+	OpGet8uint64Delta            = "decode.Get8uint64Delta"
+	OpGet8uint16                 = "decode.Get8uint16"
+	OpGet8uint16Delta            = "decode.Get8uint16Delta"
+	OpGet8uint32Variant0124      = "decode.Get8uint32Variant0124"
+	OpGet8uint32DeltaVariant0124 = "decode.Get8uint32DeltaVariant0124"
+	OpControlLen                 = "decode.ControlLen"
+)
+
+// defineOps defines the ops of this package along with their built-in
+// kernels.
+func defineOps() {
+	scalar := func(fn interface{}) shared.Kernel {
+		return shared.Kernel{Name: shared.KernelScalar, Tier: shared.TierScalar, Func: fn}
+	}
+
+	shared.Define(OpGet8uint32, scalar(Get8uint32Scalar))
+	shared.Define(OpGet8uint32Delta, scalar(Get8uint32DeltaScalar))
+	shared.Define(OpGet8int32, scalar(Get8int32Scalar))
+	shared.Define(OpGet8uint64, scalar(Get8uint64Scalar))
+	shared.Define(OpGet8uint64Delta, scalar(Get8uint64DeltaScalar))
+	shared.Define(OpGet8uint16, scalar(Get8uint16Scalar))
+	shared.Define(OpGet8uint16Delta, scalar(Get8uint16DeltaScalar))
+	shared.Define(OpGet8uint32Variant0124, scalar(Get8uint32Scalar0124))
+	shared.Define(OpGet8uint32DeltaVariant0124, scalar(Get8uint32DeltaScalar0124))
+	shared.Define(OpControlLen, scalar(ControlLenScalar))
+
+	defineFastOps()
+}
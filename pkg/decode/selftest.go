@@ -0,0 +1,53 @@
+package decode
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// selfTestKernel is an accelerated kernel checked by SelfTest.
+type selfTestKernel struct {
+	name      string
+	get8      func(in []byte, out []uint32, ctrl uint16)
+	get8Delta func(in []byte, out []uint32, ctrl uint16, prev uint32)
+}
+
+// SelfTest checks the accelerated Get8uint32 and Get8uint32Delta kernels
+// supported by the CPU against the known vectors of
+// shared.SelfTestVectors. If any of them decodes a different vector, it
+// permanently falls back to the portable Go implementation using
+// shared.Fallback and returns an error wrapping shared.ErrSelfTest. It is
+// run during package initialization if opted into using
+// shared.SelfTestEnv, and must not be called concurrently with any other
+// func of this package otherwise.
+func SelfTest() error {
+	for _, k := range selfTestKernels() {
+		for _, v := range shared.SelfTestVectors() {
+			// The kernels load 16 bytes at a time, thus pad the input.
+			in := make([]byte, len(v.Data)+16)
+			copy(in, v.Data)
+			out := make([]uint32, 8)
+			k.get8(in, out, v.Ctrl)
+			if !reflect.DeepEqual(out, v.Nums) {
+				return selfTestFailed(k.name, "Get8uint32", v.Nums)
+			}
+
+			in = make([]byte, len(v.DeltaData)+16)
+			copy(in, v.DeltaData)
+			out = make([]uint32, 8)
+			k.get8Delta(in, out, v.DeltaCtrl, v.Prev)
+			if !reflect.DeepEqual(out, v.Nums) {
+				return selfTestFailed(k.name, "Get8uint32Delta", v.Nums)
+			}
+		}
+	}
+	return nil
+}
+
+func selfTestFailed(kernel, fn string, nums []uint32) error {
+	err := fmt.Errorf("%w: %s %s kernel decoded %v wrongly", shared.ErrSelfTest, kernel, fn, nums)
+	shared.Fallback(err.Error())
+	return err
+}
@@ -13,19 +13,86 @@ const (
 var (
 	putImpl      Put8Impl
 	putDeltaImpl Put8DeltaImpl
+	putInt32Impl Put8Int32Impl
+
+	putUint64Impl      Put8Uint64Impl
+	putUint64DeltaImpl Put8Uint64DeltaImpl
+
+	putUint16Impl      Put8Uint16Impl
+	putUint16DeltaImpl Put8Uint16DeltaImpl
+
+	put0124Impl      Put8Impl
+	putDelta0124Impl Put8DeltaImpl
 )
 
 type Put8Impl func(in []uint32, out []byte) (ctrl uint16)
 type Put8DeltaImpl func(in []uint32, out []byte, prev uint32) (ctrl uint16)
+type Put8Int32Impl func(in []int32, out []byte) (ctrl uint16)
+type Put8Uint64Impl func(in []uint64, out []byte) (ctrl uint16)
+type Put8Uint64DeltaImpl func(in []uint64, out []byte, prev uint64) (ctrl uint16)
+type Put8Uint16Impl func(in []uint16, out []byte) (ctrl uint8)
+type Put8Uint16DeltaImpl func(in []uint16, out []byte, prev uint16) (ctrl uint8)
+
+// tier is the tier of kernels currently in use.
+var tier = detectTier()
 
 func init() {
-	if GetMode() == shared.Fast {
-		putImpl = Put8uint32Fast
-		putDeltaImpl = Put8uint32DeltaFast
-	} else {
-		putImpl = Put8uint32Scalar
-		putDeltaImpl = Put8uint32DeltaScalar
+	defineOps()
+	if shared.SelfTestEnabled() {
+		SelfTest()
+	}
+	setImpls()
+	shared.OnReselect(setImpls)
+}
+
+// GetTier returns the tier of kernels currently in use. Unless forced
+// using SetTier, or to shared.TierScalar using shared.SetMode or
+// shared.Fallback, it is the highest tier supported by the CPU.
+func GetTier() shared.Tier {
+	if shared.GetMode() == shared.Normal {
+		return shared.TierScalar
+	}
+	if t := SupportedTier(); tier > t {
+		return t
+	}
+	return tier
+}
+
+// SupportedTier returns the highest tier of kernels supported by the CPU,
+// which is shared.TierScalar after shared.Fallback.
+func SupportedTier() shared.Tier {
+	if shared.FallbackReason() != "" {
+		return shared.TierScalar
 	}
+	return detectTier()
+}
+
+// SetTier forces the tier of kernels used by this package, e.g. to test a
+// lower tier on a CPU supporting a higher one. It returns
+// shared.ErrUnsupportedTier if the CPU does not support t. SetTier must
+// not be called concurrently with any other func of this package.
+func SetTier(t shared.Tier) error {
+	if t < shared.TierScalar || t > SupportedTier() {
+		return shared.ErrUnsupportedTier
+	}
+	tier = t
+	setImpls()
+	return nil
+}
+
+// setImpls selects the implementations used by the general funcs
+// according to the current tier and the registered kernels.
+func setImpls() {
+	t := GetTier()
+	putImpl = shared.Use(OpPut8uint32, t).Func.(func([]uint32, []byte) uint16)
+	putDeltaImpl = shared.Use(OpPut8uint32Delta, t).Func.(func([]uint32, []byte, uint32) uint16)
+	putInt32Impl = shared.Use(OpPut8int32, t).Func.(func([]int32, []byte) uint16)
+	putUint64Impl = shared.Use(OpPut8uint64, t).Func.(func([]uint64, []byte) uint16)
+	putUint64DeltaImpl = shared.Use(OpPut8uint64Delta, t).Func.(func([]uint64, []byte, uint64) uint16)
+	putUint16Impl = shared.Use(OpPut8uint16, t).Func.(func([]uint16, []byte) uint8)
+	putUint16DeltaImpl = shared.Use(OpPut8uint16Delta, t).Func.(func([]uint16, []byte, uint16) uint8)
+	put0124Impl = shared.Use(OpPut8uint32Variant0124, t).Func.(func([]uint32, []byte) uint16)
+	putDelta0124Impl = shared.Use(OpPut8uint32DeltaVariant0124, t).Func.(func([]uint32, []byte, uint32) uint16)
 }
 
 // Put8uint32 is a general func you can use to encode 8 uint32's at a time.
@@ -46,6 +113,15 @@ func Put8uint32Delta(in []uint32, out []byte, prev uint32) uint16 {
 	return putDeltaImpl(in, out, prev)
 }
 
+// Put8int32 is a general func you can use to encode 8 int32's at a time.
+// Every integer is zigzag encoded prior to being encoded using the Stream
+// VByte format, so that integers with a small magnitude use fewer bytes
+// regardless of their sign. It will use the fastest implementation available
+// determined during package initialization.
+func Put8int32(in []int32, out []byte) uint16 {
+	return putInt32Impl(in, out)
+}
+
 // PutUint32Scalar encodes up to 4 integers from in into out using the
 // Stream VByte format.
 //
@@ -210,8 +286,54 @@ func Put4uint32DeltaScalar(in []uint32, out []byte, prev uint32) uint8 {
 	return uint8((len0 - 1) | (len1-1)<<2 | (len2-1)<<4 | (len3-1)<<6)
 }
 
+// SizeUint32 returns the number of bytes needed to encode num using the
+// Stream VByte format.
+func SizeUint32(num uint32) int {
+	return max(1, 4-(bits.LeadingZeros32(num)/8))
+}
+
+// Put8int32Scalar will zigzag encode 8 int32 values from in and then encode
+// them into out using the Stream VByte format. Returns an 16-bit control
+// value produced from the encoding.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Put8int32Scalar(in []int32, out []byte) uint16 {
+	// bounds check hint to compiler
+	_ = in[7]
+
+	var nums [8]uint32
+	for i := range nums {
+		nums[i] = zigzag(in[i])
+	}
+	return Put8uint32Scalar(nums[:], out)
+}
+
+// PutInt32Scalar zigzag encodes up to 4 integers from in and encodes them
+// into out using the Stream VByte format.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func PutInt32Scalar(in []int32, out []byte, count int) uint8 {
+	if count > 4 {
+		count = 4
+	}
+
+	var nums [4]uint32
+	for i := 0; i < count; i++ {
+		nums[i] = zigzag(in[i])
+	}
+	return PutUint32Scalar(nums[:], out, count)
+}
+
+// zigzag maps signed integers onto unsigned integers such that
+// 0, -1, 1, -2, 2 ... become 0, 1, 2, 3, 4 ...
+func zigzag(num int32) uint32 {
+	return uint32(num<<1) ^ uint32(num>>31)
+}
+
 func encodeOne(num uint32, out []byte) int {
-	size := max(1, 4-(bits.LeadingZeros32(num)/8))
+	size := SizeUint32(num)
 	switch size {
 	case 4:
 		out[3] = byte(num >> 24)
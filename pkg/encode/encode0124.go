@@ -0,0 +1,183 @@
+package encode
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// Put8uint32Variant is a general func you can use to encode 8 uint32's at a
+// time using the provided variant of Stream VByte. It will use the fastest
+// implementation available determined during package initialization.
+func Put8uint32Variant(in []uint32, out []byte, v shared.Variant) uint16 {
+	if v == shared.Variant0124 {
+		return put0124Impl(in, out)
+	}
+	return putImpl(in, out)
+}
+
+// Put8uint32DeltaVariant is a general func you can use to encode 8
+// differentially coded uint32's at a time using the provided variant of
+// Stream VByte. It will use the fastest implementation available determined
+// during package initialization.
+func Put8uint32DeltaVariant(in []uint32, out []byte, prev uint32, v shared.Variant) uint16 {
+	if v == shared.Variant0124 {
+		return putDelta0124Impl(in, out, prev)
+	}
+	return putDeltaImpl(in, out, prev)
+}
+
+// Put8uint32Scalar0124 will encode 8 uint32 values from in into out using
+// the "0124" variant of Stream VByte. Returns an 16-bit control value
+// produced from the encoding.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Put8uint32Scalar0124(in []uint32, out []byte) uint16 {
+	var ctrl uint16
+	first := Put4uint32Scalar0124(in, out)
+	ctrl |= uint16(first)
+	encoded := shared.ControlByteToSize0124(first)
+	second := Put4uint32Scalar0124(in[4:], out[encoded:])
+	return ctrl | uint16(second)<<8
+}
+
+// Put4uint32Scalar0124 will encode 4 uint32 values from in into out using
+// the "0124" variant of Stream VByte. Returns an 8-bit control value
+// produced from the encoding. Unlike the standard variant, the 2-bit codes
+// map onto lengths of 0, 1, 2 and 4 bytes, thus zeros take up no data
+// bytes at all.
+//
+// Num         Len      2-bit control
+// ----------------------------------
+// 0            0                0b00
+// 111          1                0b01
+// 1234         2                0b10
+// 789123       4                0b11
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Put4uint32Scalar0124(in []uint32, out []byte) uint8 {
+	// bounds check hint to compiler
+	_ = in[3]
+
+	code0, len0 := encodeOne0124(in[0], out)
+	code1, len1 := encodeOne0124(in[1], out[len0:])
+	code2, len2 := encodeOne0124(in[2], out[len0+len1:])
+	code3, _ := encodeOne0124(in[3], out[len0+len1+len2:])
+
+	return code0 | code1<<2 | code2<<4 | code3<<6
+}
+
+// Put8uint32DeltaScalar0124 will differentially encode 8 uint32 values from
+// in into out using the "0124" variant of Stream VByte. Prev provides a way
+// for you to indicate the base value for this batch of 8. Note that this
+// func assumes that the input integers are already sorted.
+func Put8uint32DeltaScalar0124(in []uint32, out []byte, prev uint32) uint16 {
+	var ctrl uint16
+	first := Put4uint32DeltaScalar0124(in, out, prev)
+	ctrl |= uint16(first)
+	encoded := shared.ControlByteToSize0124(first)
+	second := Put4uint32DeltaScalar0124(in[4:], out[encoded:], in[3])
+	return ctrl | uint16(second)<<8
+}
+
+// Put4uint32DeltaScalar0124 will differentially encode 4 uint32 values from
+// in into out using the "0124" variant of Stream VByte. Prev provides a way
+// for you to indicate the base value for this batch of 4. Note that this
+// func assumes that the input integers are already sorted.
+func Put4uint32DeltaScalar0124(in []uint32, out []byte, prev uint32) uint8 {
+	// bounds check hint to compiler
+	_ = in[3]
+
+	code0, len0 := encodeOne0124(in[0]-prev, out)
+	code1, len1 := encodeOne0124(in[1]-in[0], out[len0:])
+	code2, len2 := encodeOne0124(in[2]-in[1], out[len0+len1:])
+	code3, _ := encodeOne0124(in[3]-in[2], out[len0+len1+len2:])
+
+	return code0 | code1<<2 | code2<<4 | code3<<6
+}
+
+// PutUint32Scalar0124 encodes up to 4 integers from in into out using the
+// "0124" variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func PutUint32Scalar0124(in []uint32, out []byte, count int) uint8 {
+	if count > 4 {
+		count = 4
+	}
+
+	var (
+		ctrl  uint8
+		shift = 0
+		total = 0
+	)
+	for i := 0; i < count; i++ {
+		code, size := encodeOne0124(in[i], out[total:])
+		total += size
+		ctrl |= code << shift
+		shift += 2
+	}
+
+	return ctrl
+}
+
+// PutUint32DeltaScalar0124 encodes up to 4 differentially coded integers
+// from in into out using the "0124" variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func PutUint32DeltaScalar0124(in []uint32, out []byte, count int, prev uint32) uint8 {
+	if count > 4 {
+		count = 4
+	}
+
+	var (
+		ctrl  uint8
+		shift = 0
+		total = 0
+	)
+	for i := 0; i < count; i++ {
+		code, size := encodeOne0124(in[i]-prev, out[total:])
+		total += size
+		ctrl |= code << shift
+		shift += 2
+		prev = in[i]
+	}
+
+	return ctrl
+}
+
+// SizeUint320124 returns the number of bytes needed to encode num using the
+// "0124" variant of Stream VByte.
+func SizeUint320124(num uint32) int {
+	_, size := code0124(num)
+	return size
+}
+
+func code0124(num uint32) (uint8, int) {
+	switch {
+	case num == 0:
+		return 0, 0
+	case num < 1<<8:
+		return 1, 1
+	case num < 1<<16:
+		return 2, 2
+	}
+	return 3, 4
+}
+
+func encodeOne0124(num uint32, out []byte) (uint8, int) {
+	code, size := code0124(num)
+	switch size {
+	case 4:
+		out[3] = byte(num >> 24)
+		out[2] = byte(num >> 16)
+		fallthrough
+	case 2:
+		out[1] = byte(num >> 8)
+		fallthrough
+	case 1:
+		out[0] = byte(num)
+	}
+	return code, size
+}
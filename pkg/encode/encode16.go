@@ -0,0 +1,113 @@
+package encode
+
+const (
+	MaxBytesPerNum16 = 2
+)
+
+// Put8uint16 is a general func you can use to encode 8 uint16's at a time
+// using the 16-bit variant of Stream VByte. It will use the fastest
+// implementation available determined during package initialization.
+func Put8uint16(in []uint16, out []byte) uint8 {
+	return putUint16Impl(in, out)
+}
+
+// Put8uint16Delta is a general func you can use to encode 8 differentially
+// coded uint16's at a time using the 16-bit variant of Stream VByte. It will
+// use the fastest implementation available determined during package
+// initialization.
+func Put8uint16Delta(in []uint16, out []byte, prev uint16) uint8 {
+	return putUint16DeltaImpl(in, out, prev)
+}
+
+// Put8uint16Scalar will encode 8 uint16 values from in into out using the
+// 16-bit variant of Stream VByte. Returns an 8-bit control value produced
+// from the encoding. Every integer is given a 1-bit code, 0 when it fits
+// into a single byte and 1 when it needs two, thus a single control byte
+// describes all 8 integers.
+//
+// Num         Len      1-bit control
+// ----------------------------------
+// 111          1                 0b0
+// 1234         2                 0b1
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Put8uint16Scalar(in []uint16, out []byte) uint8 {
+	return PutUint16Scalar(in, out, 8)
+}
+
+// Put8uint16DeltaScalar will differentially encode 8 uint16 values from in
+// into out using the 16-bit variant of Stream VByte. Prev provides a way for
+// you to indicate the base value for this batch of 8. Note that this func
+// assumes that the input integers are already sorted.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Put8uint16DeltaScalar(in []uint16, out []byte, prev uint16) uint8 {
+	return PutUint16DeltaScalar(in, out, 8, prev)
+}
+
+// PutUint16Scalar encodes up to 8 integers from in into out using the
+// 16-bit variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func PutUint16Scalar(in []uint16, out []byte, count int) uint8 {
+	if count > 8 {
+		count = 8
+	}
+
+	var (
+		ctrl  uint8
+		total = 0
+	)
+	for i := 0; i < count; i++ {
+		code, size := encodeOne16(in[i], out[total:])
+		total += size
+		ctrl |= code << i
+	}
+
+	return ctrl
+}
+
+// PutUint16DeltaScalar encodes up to 8 differentially coded integers from
+// in into out using the 16-bit variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func PutUint16DeltaScalar(in []uint16, out []byte, count int, prev uint16) uint8 {
+	if count > 8 {
+		count = 8
+	}
+
+	var (
+		ctrl  uint8
+		total = 0
+	)
+	for i := 0; i < count; i++ {
+		code, size := encodeOne16(in[i]-prev, out[total:])
+		total += size
+		ctrl |= code << i
+		prev = in[i]
+	}
+
+	return ctrl
+}
+
+// SizeUint16 returns the number of bytes needed to encode num using the
+// 16-bit variant of Stream VByte.
+func SizeUint16(num uint16) int {
+	if num > 0xff {
+		return 2
+	}
+	return 1
+}
+
+func encodeOne16(num uint16, out []byte) (uint8, int) {
+	out[0] = byte(num)
+	if num > 0xff {
+		out[1] = byte(num >> 8)
+		return 1, 2
+	}
+	return 0, 1
+}
@@ -0,0 +1,189 @@
+package encode
+
+import (
+	"math/bits"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+const (
+	MaxBytesPerNum64 = 8
+)
+
+// Put8uint64 is a general func you can use to encode 8 uint64's at a time
+// using the 64-bit variant of Stream VByte. It will use the fastest
+// implementation available determined during package initialization.
+func Put8uint64(in []uint64, out []byte) uint16 {
+	return putUint64Impl(in, out)
+}
+
+// Put8uint64Delta is a general func you can use to encode 8 differentially
+// coded uint64's at a time using the 64-bit variant of Stream VByte. It will
+// use the fastest implementation available determined during package
+// initialization.
+func Put8uint64Delta(in []uint64, out []byte, prev uint64) uint16 {
+	return putUint64DeltaImpl(in, out, prev)
+}
+
+// Put8uint64Scalar will encode 8 uint64 values from in into out using the
+// 64-bit variant of Stream VByte. Returns an 16-bit control value produced
+// from the encoding.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Put8uint64Scalar(in []uint64, out []byte) uint16 {
+	var ctrl uint16
+	first := Put4uint64Scalar(in, out)
+	ctrl |= uint16(first)
+	encoded := shared.ControlByteToSize64(first)
+	second := Put4uint64Scalar(in[4:], out[encoded:])
+	return ctrl | uint16(second)<<8
+}
+
+// Put4uint64Scalar will encode 4 uint64 values from in into out using the
+// 64-bit variant of Stream VByte. Returns an 8-bit control value produced
+// from the encoding. The 64-bit variant uses the same 2-bit codes as the
+// 32-bit variant, however the codes map onto lengths of 1, 2, 4 and 8 bytes.
+//
+// Num                  Len      2-bit control
+// -------------------------------------------
+// 111                   1                0b00
+// 1234                  2                0b01
+// 789123                4                0b10
+// 1099511627776         8                0b11
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func Put4uint64Scalar(in []uint64, out []byte) uint8 {
+	// bounds check hint to compiler
+	_ = in[3]
+
+	code0, len0 := encodeOne64(in[0], out)
+	code1, len1 := encodeOne64(in[1], out[len0:])
+	code2, len2 := encodeOne64(in[2], out[len0+len1:])
+	code3, _ := encodeOne64(in[3], out[len0+len1+len2:])
+
+	return code0 | code1<<2 | code2<<4 | code3<<6
+}
+
+// Put8uint64DeltaScalar will differentially encode 8 uint64 values from in
+// into out using the 64-bit variant of Stream VByte. Prev provides a way for
+// you to indicate the base value for this batch of 8. Note that this func
+// assumes that the input integers are already sorted.
+func Put8uint64DeltaScalar(in []uint64, out []byte, prev uint64) uint16 {
+	var ctrl uint16
+	first := Put4uint64DeltaScalar(in, out, prev)
+	ctrl |= uint16(first)
+	encoded := shared.ControlByteToSize64(first)
+	second := Put4uint64DeltaScalar(in[4:], out[encoded:], in[3])
+	return ctrl | uint16(second)<<8
+}
+
+// Put4uint64DeltaScalar will differentially encode 4 uint64 values from in
+// into out using the 64-bit variant of Stream VByte. Prev provides a way for
+// you to indicate the base value for this batch of 4. Note that this func
+// assumes that the input integers are already sorted.
+func Put4uint64DeltaScalar(in []uint64, out []byte, prev uint64) uint8 {
+	// bounds check hint to compiler
+	_ = in[3]
+
+	code0, len0 := encodeOne64(in[0]-prev, out)
+	code1, len1 := encodeOne64(in[1]-in[0], out[len0:])
+	code2, len2 := encodeOne64(in[2]-in[1], out[len0+len1:])
+	code3, _ := encodeOne64(in[3]-in[2], out[len0+len1+len2:])
+
+	return code0 | code1<<2 | code2<<4 | code3<<6
+}
+
+// PutUint64Scalar encodes up to 4 integers from in into out using the
+// 64-bit variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func PutUint64Scalar(in []uint64, out []byte, count int) uint8 {
+	if count > 4 {
+		count = 4
+	}
+
+	var (
+		ctrl  uint8
+		shift = 0
+		total = 0
+	)
+	for i := 0; i < count; i++ {
+		code, size := encodeOne64(in[i], out[total:])
+		total += size
+		ctrl |= code << shift
+		shift += 2
+	}
+
+	return ctrl
+}
+
+// PutUint64DeltaScalar encodes up to 4 differentially coded integers from
+// in into out using the 64-bit variant of Stream VByte.
+//
+// Note: It is your responsibility to ensure that the incoming slices have
+// the appropriate sizes and data otherwise this func will panic.
+func PutUint64DeltaScalar(in []uint64, out []byte, count int, prev uint64) uint8 {
+	if count > 4 {
+		count = 4
+	}
+
+	var (
+		ctrl  uint8
+		shift = 0
+		total = 0
+	)
+	for i := 0; i < count; i++ {
+		code, size := encodeOne64(in[i]-prev, out[total:])
+		total += size
+		ctrl |= code << shift
+		shift += 2
+		prev = in[i]
+	}
+
+	return ctrl
+}
+
+// SizeUint64 returns the number of bytes needed to encode num using the
+// 64-bit variant of Stream VByte.
+func SizeUint64(num uint64) int {
+	return 1 << code64(num)
+}
+
+// code64 returns the 2-bit code for num, i.e. the log2 of its
+// encoded length.
+func code64(num uint64) uint8 {
+	switch used := 8 - bits.LeadingZeros64(num)/8; {
+	case used > 4:
+		return 3
+	case used > 2:
+		return 2
+	case used > 1:
+		return 1
+	}
+	return 0
+}
+
+func encodeOne64(num uint64, out []byte) (uint8, int) {
+	code := code64(num)
+	switch code {
+	case 3:
+		out[7] = byte(num >> 56)
+		out[6] = byte(num >> 48)
+		out[5] = byte(num >> 40)
+		out[4] = byte(num >> 32)
+		fallthrough
+	case 2:
+		out[3] = byte(num >> 24)
+		out[2] = byte(num >> 16)
+		fallthrough
+	case 1:
+		out[1] = byte(num >> 8)
+		fallthrough
+	case 0:
+		out[0] = byte(num)
+	}
+	return code, 1 << code
+}
@@ -10,15 +10,67 @@ import (
 	"golang.org/x/sys/cpu"
 )
 
-// GetMode performs a check to see if the current ISA supports
-// the below encoding funcs.
+// GetMode reports whether the below encoding funcs are in use, i.e.
+// whether the current tier is shared.TierAVX2.
 func GetMode() shared.PerformanceMode {
-	if cpu.X86.HasAVX && cpu.X86.HasAVX2 {
+	if GetTier() == shared.TierAVX2 {
 		return shared.Fast
 	}
 	return shared.Normal
 }
 
+// detectTier performs a check to see which tier of kernels the
+// current ISA supports.
+func detectTier() shared.Tier {
+	switch {
+	case cpu.X86.HasAVX && cpu.X86.HasAVX2:
+		return shared.TierAVX2
+	case cpu.X86.HasSSSE3 && cpu.X86.HasSSE41:
+		return shared.TierSSE41
+	}
+	return shared.TierScalar
+}
+
+// selfTestKernels returns the accelerated kernels supported by the CPU
+// that SelfTest checks.
+func selfTestKernels() []selfTestKernel {
+	var kernels []selfTestKernel
+	if detectTier() >= shared.TierSSE41 {
+		kernels = append(kernels, selfTestKernel{shared.KernelSSE41, Put8uint32SSE, Put8uint32DeltaSSE})
+	}
+	if detectTier() >= shared.TierAVX2 {
+		kernels = append(kernels, selfTestKernel{shared.KernelAVX2, Put8uint32Fast, Put8uint32DeltaFast})
+	}
+	return kernels
+}
+
+// defineFastOps adds the SSE and AVX kernels to the ops of this package.
+func defineFastOps() {
+	var (
+		sse = []string{shared.FeatureSSSE3, shared.FeatureSSE41}
+		avx = []string{shared.FeatureAVX, shared.FeatureAVX2}
+	)
+	kernel := func(name string, t shared.Tier, features []string, fn interface{}) shared.Kernel {
+		return shared.Kernel{Name: name, Tier: t, Features: features, Func: fn}
+	}
+
+	shared.Define(OpPut8uint32,
+		kernel(shared.KernelSSE41, shared.TierSSE41, sse, Put8uint32SSE),
+		kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8uint32Fast),
+	)
+	shared.Define(OpPut8uint32Delta,
+		kernel(shared.KernelSSE41, shared.TierSSE41, sse, Put8uint32DeltaSSE),
+		kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8uint32DeltaFast),
+	)
+	shared.Define(OpPut8int32, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8int32Fast))
+	shared.Define(OpPut8uint64, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8uint64Fast))
+	shared.Define(OpPut8uint64Delta, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8uint64DeltaFast))
+	shared.Define(OpPut8uint16, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8uint16Fast))
+	shared.Define(OpPut8uint16Delta, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8uint16DeltaFast))
+	shared.Define(OpPut8uint32Variant0124, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8uint32Fast0124))
+	shared.Define(OpPut8uint32DeltaVariant0124, kernel(shared.KernelAVX2, shared.TierAVX2, avx, Put8uint32DeltaFast0124))
+}
+
 // Put8uint32Fast binds to put8uint32Fast which is implemented
 // in assembly.
 func Put8uint32Fast(in []uint32, out []byte) uint16 {
@@ -38,6 +90,134 @@ func Put8uint32DeltaFast(in []uint32, out []byte, prev uint32) uint16 {
 	)
 }
 
+// Put8uint32SSE binds to Put8uint32SSEAsm which is implemented in
+// assembly.
+func Put8uint32SSE(in []uint32, out []byte) uint16 {
+	return Put8uint32SSEAsm(in, out,
+		shared.EncodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// Put8uint32DeltaSSE binds to Put8uint32DeltaSSEAsm which is implemented
+// in assembly.
+func Put8uint32DeltaSSE(in []uint32, out []byte, prev uint32) uint16 {
+	return Put8uint32DeltaSSEAsm(
+		in, out, prev,
+		shared.EncodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// Put4uint32Fast encodes 4 uint32s from in into out using
+// PutNuint32FastAsm. Unlike Put8uint32Fast, it neither reads past the 4
+// integers nor writes past their encoding.
+func Put4uint32Fast(in []uint32, out []byte) uint8 {
+	return PutNuint32Fast(in, out, 4)
+}
+
+// Put4uint32DeltaFast works similarly to Put4uint32Fast except that the
+// integers are differentially coded using PutNuint32DeltaFastAsm.
+func Put4uint32DeltaFast(in []uint32, out []byte, prev uint32) uint8 {
+	return PutNuint32DeltaFast(in, out, 4, prev)
+}
+
+// PutNuint32Fast encodes up to 4 integers from in into out the same way
+// as PutUint32Scalar, e.g. the last integers of a stream, binding to
+// PutNuint32FastAsm which is implemented in assembly.
+func PutNuint32Fast(in []uint32, out []byte, count int) uint8 {
+	if count == 0 {
+		return 0
+	}
+
+	if count > 4 {
+		count = 4
+	}
+
+	return PutNuint32FastAsm(in[:count], out, count,
+		shared.EncodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// PutNuint32DeltaFast encodes up to 4 integers from in into out the same
+// way as PutUint32DeltaScalar, binding to PutNuint32DeltaFastAsm which is
+// implemented in assembly.
+func PutNuint32DeltaFast(in []uint32, out []byte, count int, prev uint32) uint8 {
+	if count == 0 {
+		return 0
+	}
+
+	if count > 4 {
+		count = 4
+	}
+
+	return PutNuint32DeltaFastAsm(
+		in[:count], out, count, prev,
+		shared.EncodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// Put8int32Fast binds to Put8int32FastAsm which is implemented
+// in assembly.
+func Put8int32Fast(in []int32, out []byte) uint16 {
+	return Put8int32FastAsm(in, out,
+		shared.EncodeShuffleTable,
+		shared.PerControlLenTable,
+	)
+}
+
+// Put8uint64Fast binds to Put8uint64FastAsm which is implemented
+// in assembly.
+func Put8uint64Fast(in []uint64, out []byte) uint16 {
+	return Put8uint64FastAsm(in, out,
+		shared.EncodeShuffleTable64,
+		shared.PerPairLenTable64,
+	)
+}
+
+// Put8uint64DeltaFast binds to Put8uint64DeltaFastAsm which is
+// implemented in assembly.
+func Put8uint64DeltaFast(in []uint64, out []byte, prev uint64) uint16 {
+	return Put8uint64DeltaFastAsm(
+		in, out, prev,
+		shared.EncodeShuffleTable64,
+		shared.PerPairLenTable64,
+	)
+}
+
+// Put8uint16Fast binds to Put8uint16FastAsm which is implemented
+// in assembly.
+func Put8uint16Fast(in []uint16, out []byte) uint8 {
+	return Put8uint16FastAsm(in, out, shared.EncodeShuffleTable16)
+}
+
+// Put8uint16DeltaFast binds to Put8uint16DeltaFastAsm which is
+// implemented in assembly.
+func Put8uint16DeltaFast(in []uint16, out []byte, prev uint16) uint8 {
+	return Put8uint16DeltaFastAsm(in, out, prev, shared.EncodeShuffleTable16)
+}
+
+// Put8uint32Fast0124 binds to Put8uint32FastAsm0124 which is
+// implemented in assembly.
+func Put8uint32Fast0124(in []uint32, out []byte) uint16 {
+	return Put8uint32FastAsm0124(in, out,
+		shared.EncodeShuffleTable0124,
+		shared.PerControlLenTable0124,
+	)
+}
+
+// Put8uint32DeltaFast0124 binds to Put8uint32DeltaFastAsm0124 which is
+// implemented in assembly.
+func Put8uint32DeltaFast0124(in []uint32, out []byte, prev uint32) uint16 {
+	return Put8uint32DeltaFastAsm0124(
+		in, out, prev,
+		shared.EncodeShuffleTable0124,
+		shared.PerControlLenTable0124,
+	)
+}
+
 // Put8uint32FastAsm has three core phases. First a 16-bit control is
 // generated for the incoming 8 uint32s. Then, the calculated control
 // is used to index into shared.EncodeShuffleTable to fetch the
@@ -213,3 +393,142 @@ func Put8uint32DeltaFastAsm(
 	in []uint32, outBytes []byte, prev uint32,
 	shuffle *[256][16]uint8, lenTable *[256]uint8,
 ) (r uint16)
+
+// Put8int32FastAsm works similarly to Put8uint32FastAsm except that
+// the 8 int32s are first zigzag encoded using SIMD techniques so that
+// integers with a small magnitude use fewer bytes regardless of their
+// sign. The zigzag encoding is as follows:
+//
+// Input:           [A B C D]
+// Shift left:      [A<<1 B<<1 C<<1 D<<1]
+// Arith shift:     [A>>31 B>>31 C>>31 D>>31]
+// Xor above two:   [(A<<1)^(A>>31) ...]
+//go:noescape
+func Put8int32FastAsm(
+	in []int32, outBytes []byte,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint16)
+
+// Ctrl8uint32FastAsm generates the 16-bit control for the 8 uint32s in
+// in without encoding them. It uses the same SIMD control byte
+// generation algorithm as Put8uint32FastAsm and can be used to compute
+// the encoded size of the integers ahead of time.
+//go:noescape
+func Ctrl8uint32FastAsm(in []uint32) (r uint16)
+
+// Ctrl8uint32DeltaFastAsm works similarly to Ctrl8uint32FastAsm except
+// that the control is generated for the differentially coded values,
+// using prev as the base value for the batch of 8.
+//go:noescape
+func Ctrl8uint32DeltaFastAsm(in []uint32, prev uint32) (r uint16)
+
+// Put8uint64FastAsm encodes 8 uint64s using the 64-bit variant of
+// Stream VByte. The integers are processed in pairs, one per XMM
+// register. The 2-bit code of every integer is derived by counting the
+// right shifts by 8, 16 and 32 bits that leave a non-zero value behind,
+// which maps onto lengths of 1, 2, 4 and 8 bytes. Every code is then
+// shifted into place with a variable shift and the codes are or'ed
+// together into the 16-bit control. Each 4-bit nibble of the control
+// indexes into shared.EncodeShuffleTable64 and shared.PerPairLenTable64
+// to compress and write out a single pair.
+//go:noescape
+func Put8uint64FastAsm(
+	in []uint64, outBytes []byte,
+	shuffle *[16][16]uint8, lenTable *[16]uint8,
+) (r uint16)
+
+// Put8uint64DeltaFastAsm works similarly to Put8uint64FastAsm except
+// that prior to encoding the 8 uint64s, they are first converted into
+// deltas. The differential coding is performed pair by pair:
+//
+// Prev:            [P P]
+// Input:           [A B]
+// Concat-shift:    [P A]
+// Subtract:        [A-P B-A]
+//go:noescape
+func Put8uint64DeltaFastAsm(
+	in []uint64, outBytes []byte, prev uint64,
+	shuffle *[16][16]uint8, lenTable *[16]uint8,
+) (r uint16)
+
+// Put8uint16FastAsm encodes 8 uint16s using the 16-bit variant of
+// Stream VByte. An integer needs two bytes exactly when its upper byte
+// is non-zero, so the 8-bit control is generated by comparing the
+// upper bytes against zero, packing the 16-bit comparison results down
+// to bytes and collecting their MSBs. The control then indexes into
+// shared.EncodeShuffleTable16 to compress the integers.
+//go:noescape
+func Put8uint16FastAsm(in []uint16, outBytes []byte, shuffle *[256][16]uint8) (r uint8)
+
+// Put8uint16DeltaFastAsm works similarly to Put8uint16FastAsm except
+// that prior to encoding the 8 uint16s, they are first converted into
+// deltas:
+//
+// Prev:            [- - - - - - - P]
+// Input:           [A B C D E F G H]
+// Concat-shift:    [P A B C D E F G]
+// Subtract:        [A-P B-A C-B D-C E-D F-E G-F H-G]
+//go:noescape
+func Put8uint16DeltaFastAsm(in []uint16, outBytes []byte, prev uint16, shuffle *[256][16]uint8) (r uint8)
+
+// Put8uint32FastAsm0124 works similarly to Put8uint32FastAsm except that
+// the 16-bit control is generated for the "0124" variant, where the
+// 2-bit codes map onto lengths of 0, 1, 2 and 4 bytes. The 2-bit code of
+// every integer is derived by counting the right shifts by 0, 8 and 16
+// bits that leave a non-zero value behind. Every code is then shifted
+// into place with a variable shift and the codes are or'ed together into
+// the 16-bit control. The compression itself is driven entirely by
+// shared.EncodeShuffleTable0124 and shared.PerControlLenTable0124.
+//go:noescape
+func Put8uint32FastAsm0124(
+	in []uint32, outBytes []byte,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint16)
+
+// Put8uint32DeltaFastAsm0124 works similarly to Put8uint32FastAsm0124
+// except that the 8 uint32s are first converted into deltas the same way
+// as in Put8uint32DeltaFastAsm.
+//go:noescape
+func Put8uint32DeltaFastAsm0124(
+	in []uint32, outBytes []byte, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint16)
+
+// Put8uint32SSEAsm works exactly like Put8uint32FastAsm using legacy SSE
+// instructions only, for CPUs without AVX2. The masks used to generate the
+// control are loaded from 16 byte tables rather than broadcast.
+//go:noescape
+func Put8uint32SSEAsm(
+	in []uint32, outBytes []byte,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint16)
+
+// Put8uint32DeltaSSEAsm works exactly like Put8uint32DeltaFastAsm using
+// legacy SSE instructions only, for CPUs without AVX2.
+//go:noescape
+func Put8uint32DeltaSSEAsm(
+	in []uint32, outBytes []byte, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint16)
+
+// PutNuint32FastAsm works similarly to Put8uint32FastAsm except that it
+// only encodes the first count, 1 to 4, uint32s of the input and returns
+// their 8-bit control. The integers are loaded using VMASKMOVPS, which
+// zeroes the remaining lanes without touching their memory, so their
+// 2-bit codes are zero. The encoding is then written out in 8, 4, 2 and 1
+// byte stores, leaving out the single byte of every zeroed lane, so that
+// nothing is written past it either.
+//go:noescape
+func PutNuint32FastAsm(
+	in []uint32, outBytes []byte, count int,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint8)
+
+// PutNuint32DeltaFastAsm works similarly to PutNuint32FastAsm except that
+// the integers are differentially coded as in Put8uint32DeltaFastAsm. The
+// deltas of the zeroed lanes are masked off again afterwards.
+//go:noescape
+func PutNuint32DeltaFastAsm(
+	in []uint32, outBytes []byte, count int, prev uint32,
+	shuffle *[256][16]uint8, lenTable *[256]uint8,
+) (r uint8)
@@ -8,6 +8,74 @@ GLOBL mask0101<>(SB), RODATA|NOPTR, $2
 DATA mask7F00<>+0(SB)/2, $0x7f00
 GLOBL mask7F00<>(SB), RODATA|NOPTR, $2
 
+DATA mask03<>+0(SB)/8, $0x0000000000000003
+GLOBL mask03<>(SB), RODATA|NOPTR, $8
+
+DATA shift64<>+0(SB)/8, $0x0000000000000000
+DATA shift64<>+8(SB)/8, $0x0000000000000002
+DATA shift64<>+16(SB)/8, $0x0000000000000004
+DATA shift64<>+24(SB)/8, $0x0000000000000006
+DATA shift64<>+32(SB)/8, $0x0000000000000008
+DATA shift64<>+40(SB)/8, $0x000000000000000a
+DATA shift64<>+48(SB)/8, $0x000000000000000c
+DATA shift64<>+56(SB)/8, $0x000000000000000e
+GLOBL shift64<>(SB), RODATA|NOPTR, $64
+
+DATA mask03D<>+0(SB)/4, $0x00000003
+GLOBL mask03D<>(SB), RODATA|NOPTR, $4
+
+DATA shift32<>+0(SB)/4, $0x00000000
+DATA shift32<>+4(SB)/4, $0x00000002
+DATA shift32<>+8(SB)/4, $0x00000004
+DATA shift32<>+12(SB)/4, $0x00000006
+DATA shift32<>+16(SB)/4, $0x00000008
+DATA shift32<>+20(SB)/4, $0x0000000a
+DATA shift32<>+24(SB)/4, $0x0000000c
+DATA shift32<>+28(SB)/4, $0x0000000e
+GLOBL shift32<>(SB), RODATA|NOPTR, $32
+
+DATA mask0101x16<>+0(SB)/2, $0x0101
+DATA mask0101x16<>+2(SB)/2, $0x0101
+DATA mask0101x16<>+4(SB)/2, $0x0101
+DATA mask0101x16<>+6(SB)/2, $0x0101
+DATA mask0101x16<>+8(SB)/2, $0x0101
+DATA mask0101x16<>+10(SB)/2, $0x0101
+DATA mask0101x16<>+12(SB)/2, $0x0101
+DATA mask0101x16<>+14(SB)/2, $0x0101
+GLOBL mask0101x16<>(SB), RODATA|NOPTR, $16
+
+DATA mask7F00x16<>+0(SB)/2, $0x7f00
+DATA mask7F00x16<>+2(SB)/2, $0x7f00
+DATA mask7F00x16<>+4(SB)/2, $0x7f00
+DATA mask7F00x16<>+6(SB)/2, $0x7f00
+DATA mask7F00x16<>+8(SB)/2, $0x7f00
+DATA mask7F00x16<>+10(SB)/2, $0x7f00
+DATA mask7F00x16<>+12(SB)/2, $0x7f00
+DATA mask7F00x16<>+14(SB)/2, $0x7f00
+GLOBL mask7F00x16<>(SB), RODATA|NOPTR, $16
+
+DATA countMask<>+0(SB)/4, $0x00000000
+DATA countMask<>+4(SB)/4, $0x00000000
+DATA countMask<>+8(SB)/4, $0x00000000
+DATA countMask<>+12(SB)/4, $0x00000000
+DATA countMask<>+16(SB)/4, $0xffffffff
+DATA countMask<>+20(SB)/4, $0x00000000
+DATA countMask<>+24(SB)/4, $0x00000000
+DATA countMask<>+28(SB)/4, $0x00000000
+DATA countMask<>+32(SB)/4, $0xffffffff
+DATA countMask<>+36(SB)/4, $0xffffffff
+DATA countMask<>+40(SB)/4, $0x00000000
+DATA countMask<>+44(SB)/4, $0x00000000
+DATA countMask<>+48(SB)/4, $0xffffffff
+DATA countMask<>+52(SB)/4, $0xffffffff
+DATA countMask<>+56(SB)/4, $0xffffffff
+DATA countMask<>+60(SB)/4, $0x00000000
+DATA countMask<>+64(SB)/4, $0xffffffff
+DATA countMask<>+68(SB)/4, $0xffffffff
+DATA countMask<>+72(SB)/4, $0xffffffff
+DATA countMask<>+76(SB)/4, $0xffffffff
+GLOBL countMask<>(SB), RODATA|NOPTR, $80
+
 // func Put8uint32FastAsm(in []uint32, outBytes []byte, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint16)
 // Requires: AVX, AVX2
 TEXT ·Put8uint32FastAsm(SB), NOSPLIT, $0-66
@@ -84,3 +152,673 @@ TEXT ·Put8uint32DeltaFastAsm(SB), NOSPLIT, $0-74
 	VMOVDQU      X0, (CX)
 	VMOVDQU      X1, (DX)
 	RET
+
+// func Ctrl8uint32FastAsm(in []uint32) (r uint16)
+// Requires: AVX, AVX2
+TEXT ·Ctrl8uint32FastAsm(SB), NOSPLIT, $0-26
+	MOVQ         in_base+0(FP), AX
+	VLDDQU       (AX), X0
+	VLDDQU       16(AX), X1
+	VPBROADCASTW mask0101<>+0(SB), X2
+	VPBROADCASTW mask7F00<>+0(SB), X3
+	VPMINUB      X2, X0, X0
+	VPMINUB      X2, X1, X1
+	VPACKUSWB    X1, X0, X0
+	VPMINSW      X2, X0, X0
+	VPADDUSW     X3, X0, X0
+	VPMOVMSKB    X0, AX
+	MOVW         AX, r+24(FP)
+	RET
+
+// func Ctrl8uint32DeltaFastAsm(in []uint32, prev uint32) (r uint16)
+// Requires: AVX, AVX2
+TEXT ·Ctrl8uint32DeltaFastAsm(SB), NOSPLIT, $0-34
+	MOVQ         in_base+0(FP), AX
+	VLDDQU       (AX), X0
+	VLDDQU       16(AX), X1
+	VPALIGNR     $0x0c, X0, X1, X2
+	VPSUBD       X2, X1, X1
+	VBROADCASTSS prev+24(FP), X2
+	VPALIGNR     $0x0c, X2, X0, X2
+	VPSUBD       X2, X0, X0
+	VPBROADCASTW mask0101<>+0(SB), X2
+	VPBROADCASTW mask7F00<>+0(SB), X3
+	VPMINUB      X2, X0, X0
+	VPMINUB      X2, X1, X1
+	VPACKUSWB    X1, X0, X0
+	VPMINSW      X2, X0, X0
+	VPADDUSW     X3, X0, X0
+	VPMOVMSKB    X0, AX
+	MOVW         AX, r+32(FP)
+	RET
+
+// func Put8int32FastAsm(in []int32, outBytes []byte, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint16)
+// Requires: AVX, AVX2
+TEXT ·Put8int32FastAsm(SB), NOSPLIT, $0-66
+	MOVQ         in_base+0(FP), AX
+	VLDDQU       (AX), X0
+	VLDDQU       16(AX), X1
+	VPSLLD       $0x01, X0, X2
+	VPSRAD       $0x1f, X0, X0
+	VPXOR        X2, X0, X0
+	VPSLLD       $0x01, X1, X2
+	VPSRAD       $0x1f, X1, X1
+	VPXOR        X2, X1, X1
+	VPBROADCASTW mask0101<>+0(SB), X2
+	VPBROADCASTW mask7F00<>+0(SB), X3
+	VPMINUB      X2, X0, X4
+	VPMINUB      X2, X1, X5
+	VPACKUSWB    X5, X4, X4
+	VPMINSW      X2, X4, X4
+	VPADDUSW     X3, X4, X4
+	VPMOVMSKB    X4, AX
+	MOVW         AX, r+64(FP)
+	MOVQ         shuffle+48(FP), CX
+	MOVBQZX      AL, DX
+	SHLQ         $0x04, DX
+	ADDQ         CX, DX
+	MOVWQZX      AX, BX
+	SHRQ         $0x08, BX
+	SHLQ         $0x04, BX
+	ADDQ         CX, BX
+	VPSHUFB      (DX), X0, X0
+	VPSHUFB      (BX), X1, X1
+	MOVQ         outBytes_base+24(FP), CX
+	MOVQ         CX, DX
+	MOVQ         lenTable+56(FP), BX
+	MOVBQZX      AL, AX
+	ADDQ         BX, AX
+	MOVBQZX      (AX), AX
+	ADDQ         AX, DX
+	VMOVDQU      X0, (CX)
+	VMOVDQU      X1, (DX)
+	RET
+
+// func Put8uint64FastAsm(in []uint64, outBytes []byte, shuffle *[16][16]uint8, lenTable *[16]uint8) (r uint16)
+// Requires: AVX, AVX2
+TEXT ·Put8uint64FastAsm(SB), NOSPLIT, $0-66
+	MOVQ         in_base+0(FP), AX
+	VLDDQU       (AX), X0
+	VLDDQU       16(AX), X1
+	VLDDQU       32(AX), X2
+	VLDDQU       48(AX), X3
+	VPXOR        X4, X4, X4
+	VPXOR        X6, X6, X6
+	VPBROADCASTQ mask03<>+0(SB), X5
+	VPSRLQ       $0x08, X0, X7
+	VPCMPEQQ     X4, X7, X8
+	VPSRLQ       $0x10, X0, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPSRLQ       $0x20, X0, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPADDQ       X5, X8, X8
+	VPSLLVQ      shift64<>+0(SB), X8, X8
+	VPOR         X8, X6, X6
+	VPSRLQ       $0x08, X1, X7
+	VPCMPEQQ     X4, X7, X8
+	VPSRLQ       $0x10, X1, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPSRLQ       $0x20, X1, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPADDQ       X5, X8, X8
+	VPSLLVQ      shift64<>+16(SB), X8, X8
+	VPOR         X8, X6, X6
+	VPSRLQ       $0x08, X2, X7
+	VPCMPEQQ     X4, X7, X8
+	VPSRLQ       $0x10, X2, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPSRLQ       $0x20, X2, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPADDQ       X5, X8, X8
+	VPSLLVQ      shift64<>+32(SB), X8, X8
+	VPOR         X8, X6, X6
+	VPSRLQ       $0x08, X3, X7
+	VPCMPEQQ     X4, X7, X8
+	VPSRLQ       $0x10, X3, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPSRLQ       $0x20, X3, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPADDQ       X5, X8, X8
+	VPSLLVQ      shift64<>+48(SB), X8, X8
+	VPOR         X8, X6, X6
+	VPSHUFD      $0x4e, X6, X7
+	VPOR         X7, X6, X6
+	VMOVQ        X6, AX
+	MOVW         AX, r+64(FP)
+	MOVQ         shuffle+48(FP), CX
+	MOVQ         lenTable+56(FP), DX
+	MOVQ         outBytes_base+24(FP), BX
+	MOVQ         AX, SI
+	ANDQ         $0x0f, SI
+	MOVBQZX      (DX)(SI*1), DI
+	SHLQ         $0x04, SI
+	VPSHUFB      (CX)(SI*1), X0, X0
+	VMOVDQU      X0, (BX)
+	ADDQ         DI, BX
+	MOVQ         AX, SI
+	SHRQ         $0x04, SI
+	ANDQ         $0x0f, SI
+	MOVBQZX      (DX)(SI*1), DI
+	SHLQ         $0x04, SI
+	VPSHUFB      (CX)(SI*1), X1, X1
+	VMOVDQU      X1, (BX)
+	ADDQ         DI, BX
+	MOVQ         AX, SI
+	SHRQ         $0x08, SI
+	ANDQ         $0x0f, SI
+	MOVBQZX      (DX)(SI*1), DI
+	SHLQ         $0x04, SI
+	VPSHUFB      (CX)(SI*1), X2, X2
+	VMOVDQU      X2, (BX)
+	ADDQ         DI, BX
+	MOVQ         AX, SI
+	SHRQ         $0x0c, SI
+	ANDQ         $0x0f, SI
+	MOVBQZX      (DX)(SI*1), DI
+	SHLQ         $0x04, SI
+	VPSHUFB      (CX)(SI*1), X3, X3
+	VMOVDQU      X3, (BX)
+	RET
+
+// func Put8uint64DeltaFastAsm(in []uint64, outBytes []byte, prev uint64, shuffle *[16][16]uint8, lenTable *[16]uint8) (r uint16)
+// Requires: AVX, AVX2
+TEXT ·Put8uint64DeltaFastAsm(SB), NOSPLIT, $0-74
+	MOVQ         in_base+0(FP), AX
+	VLDDQU       (AX), X0
+	VLDDQU       16(AX), X1
+	VLDDQU       32(AX), X2
+	VLDDQU       48(AX), X3
+	VPALIGNR     $0x08, X2, X3, X4
+	VPSUBQ       X4, X3, X3
+	VPALIGNR     $0x08, X1, X2, X4
+	VPSUBQ       X4, X2, X2
+	VPALIGNR     $0x08, X0, X1, X4
+	VPSUBQ       X4, X1, X1
+	VPBROADCASTQ prev+48(FP), X4
+	VPALIGNR     $0x08, X4, X0, X4
+	VPSUBQ       X4, X0, X0
+	VPXOR        X4, X4, X4
+	VPXOR        X6, X6, X6
+	VPBROADCASTQ mask03<>+0(SB), X5
+	VPSRLQ       $0x08, X0, X7
+	VPCMPEQQ     X4, X7, X8
+	VPSRLQ       $0x10, X0, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPSRLQ       $0x20, X0, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPADDQ       X5, X8, X8
+	VPSLLVQ      shift64<>+0(SB), X8, X8
+	VPOR         X8, X6, X6
+	VPSRLQ       $0x08, X1, X7
+	VPCMPEQQ     X4, X7, X8
+	VPSRLQ       $0x10, X1, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPSRLQ       $0x20, X1, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPADDQ       X5, X8, X8
+	VPSLLVQ      shift64<>+16(SB), X8, X8
+	VPOR         X8, X6, X6
+	VPSRLQ       $0x08, X2, X7
+	VPCMPEQQ     X4, X7, X8
+	VPSRLQ       $0x10, X2, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPSRLQ       $0x20, X2, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPADDQ       X5, X8, X8
+	VPSLLVQ      shift64<>+32(SB), X8, X8
+	VPOR         X8, X6, X6
+	VPSRLQ       $0x08, X3, X7
+	VPCMPEQQ     X4, X7, X8
+	VPSRLQ       $0x10, X3, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPSRLQ       $0x20, X3, X7
+	VPCMPEQQ     X4, X7, X7
+	VPADDQ       X7, X8, X8
+	VPADDQ       X5, X8, X8
+	VPSLLVQ      shift64<>+48(SB), X8, X8
+	VPOR         X8, X6, X6
+	VPSHUFD      $0x4e, X6, X7
+	VPOR         X7, X6, X6
+	VMOVQ        X6, AX
+	MOVW         AX, r+72(FP)
+	MOVQ         shuffle+56(FP), CX
+	MOVQ         lenTable+64(FP), DX
+	MOVQ         outBytes_base+24(FP), BX
+	MOVQ         AX, SI
+	ANDQ         $0x0f, SI
+	MOVBQZX      (DX)(SI*1), DI
+	SHLQ         $0x04, SI
+	VPSHUFB      (CX)(SI*1), X0, X0
+	VMOVDQU      X0, (BX)
+	ADDQ         DI, BX
+	MOVQ         AX, SI
+	SHRQ         $0x04, SI
+	ANDQ         $0x0f, SI
+	MOVBQZX      (DX)(SI*1), DI
+	SHLQ         $0x04, SI
+	VPSHUFB      (CX)(SI*1), X1, X1
+	VMOVDQU      X1, (BX)
+	ADDQ         DI, BX
+	MOVQ         AX, SI
+	SHRQ         $0x08, SI
+	ANDQ         $0x0f, SI
+	MOVBQZX      (DX)(SI*1), DI
+	SHLQ         $0x04, SI
+	VPSHUFB      (CX)(SI*1), X2, X2
+	VMOVDQU      X2, (BX)
+	ADDQ         DI, BX
+	MOVQ         AX, SI
+	SHRQ         $0x0c, SI
+	ANDQ         $0x0f, SI
+	MOVBQZX      (DX)(SI*1), DI
+	SHLQ         $0x04, SI
+	VPSHUFB      (CX)(SI*1), X3, X3
+	VMOVDQU      X3, (BX)
+	RET
+
+// func Put8uint16FastAsm(in []uint16, outBytes []byte, shuffle *[256][16]uint8) (r uint8)
+// Requires: AVX
+TEXT ·Put8uint16FastAsm(SB), NOSPLIT, $0-57
+	MOVQ      in_base+0(FP), AX
+	VLDDQU    (AX), X0
+	VPXOR     X1, X1, X1
+	VPSRLW    $0x08, X0, X2
+	VPCMPEQW  X1, X2, X2
+	VPACKSSWB X2, X2, X2
+	VPMOVMSKB X2, AX
+	NOTL      AX
+	MOVB      AL, r+56(FP)
+	MOVQ      shuffle+48(FP), CX
+	MOVBQZX   AL, AX
+	SHLQ      $0x04, AX
+	ADDQ      CX, AX
+	VPSHUFB   (AX), X0, X0
+	MOVQ      outBytes_base+24(FP), AX
+	VMOVDQU   X0, (AX)
+	RET
+
+// func Put8uint16DeltaFastAsm(in []uint16, outBytes []byte, prev uint16, shuffle *[256][16]uint8) (r uint8)
+// Requires: AVX
+TEXT ·Put8uint16DeltaFastAsm(SB), NOSPLIT, $0-65
+	MOVQ      in_base+0(FP), AX
+	VLDDQU    (AX), X0
+	MOVWLZX   prev+48(FP), AX
+	VMOVD     AX, X1
+	VPSLLDQ   $0x0e, X1, X1
+	VPALIGNR  $0x0e, X1, X0, X1
+	VPSUBW    X1, X0, X0
+	VPXOR     X1, X1, X1
+	VPSRLW    $0x08, X0, X2
+	VPCMPEQW  X1, X2, X2
+	VPACKSSWB X2, X2, X2
+	VPMOVMSKB X2, AX
+	NOTL      AX
+	MOVB      AL, r+64(FP)
+	MOVQ      shuffle+56(FP), CX
+	MOVBQZX   AL, AX
+	SHLQ      $0x04, AX
+	ADDQ      CX, AX
+	VPSHUFB   (AX), X0, X0
+	MOVQ      outBytes_base+24(FP), AX
+	VMOVDQU   X0, (AX)
+	RET
+
+// func Put8uint32FastAsm0124(in []uint32, outBytes []byte, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint16)
+// Requires: AVX, AVX2
+TEXT ·Put8uint32FastAsm0124(SB), NOSPLIT, $0-66
+	MOVQ         in_base+0(FP), AX
+	VLDDQU       (AX), X0
+	VLDDQU       16(AX), X1
+	VPXOR        X2, X2, X2
+	VPXOR        X4, X4, X4
+	VPBROADCASTD mask03D<>+0(SB), X3
+	VPCMPEQD     X2, X0, X6
+	VPSRLD       $0x08, X0, X5
+	VPCMPEQD     X2, X5, X5
+	VPADDD       X5, X6, X6
+	VPSRLD       $0x10, X0, X5
+	VPCMPEQD     X2, X5, X5
+	VPADDD       X5, X6, X6
+	VPADDD       X3, X6, X6
+	VPSLLVD      shift32<>+0(SB), X6, X6
+	VPOR         X6, X4, X4
+	VPCMPEQD     X2, X1, X6
+	VPSRLD       $0x08, X1, X5
+	VPCMPEQD     X2, X5, X5
+	VPADDD       X5, X6, X6
+	VPSRLD       $0x10, X1, X5
+	VPCMPEQD     X2, X5, X5
+	VPADDD       X5, X6, X6
+	VPADDD       X3, X6, X6
+	VPSLLVD      shift32<>+16(SB), X6, X6
+	VPOR         X6, X4, X4
+	VPSHUFD      $0x4e, X4, X5
+	VPOR         X5, X4, X4
+	VPSHUFD      $0xb1, X4, X5
+	VPOR         X5, X4, X4
+	VMOVD        X4, AX
+	MOVW         AX, r+64(FP)
+	MOVQ         shuffle+48(FP), CX
+	MOVBQZX      AL, DX
+	SHLQ         $0x04, DX
+	ADDQ         CX, DX
+	MOVWQZX      AX, BX
+	SHRQ         $0x08, BX
+	SHLQ         $0x04, BX
+	ADDQ         CX, BX
+	VPSHUFB      (DX), X0, X0
+	VPSHUFB      (BX), X1, X1
+	MOVQ         outBytes_base+24(FP), CX
+	MOVQ         CX, DX
+	MOVQ         lenTable+56(FP), BX
+	MOVBQZX      AL, AX
+	ADDQ         BX, AX
+	MOVBQZX      (AX), AX
+	ADDQ         AX, DX
+	VMOVDQU      X0, (CX)
+	VMOVDQU      X1, (DX)
+	RET
+
+// func Put8uint32DeltaFastAsm0124(in []uint32, outBytes []byte, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint16)
+// Requires: AVX, AVX2
+TEXT ·Put8uint32DeltaFastAsm0124(SB), NOSPLIT, $0-74
+	MOVQ         in_base+0(FP), AX
+	VLDDQU       (AX), X0
+	VLDDQU       16(AX), X1
+	VPALIGNR     $0x0c, X0, X1, X2
+	VPSUBD       X2, X1, X1
+	VBROADCASTSS prev+48(FP), X2
+	VPALIGNR     $0x0c, X2, X0, X2
+	VPSUBD       X2, X0, X0
+	VPXOR        X2, X2, X2
+	VPXOR        X4, X4, X4
+	VPBROADCASTD mask03D<>+0(SB), X3
+	VPCMPEQD     X2, X0, X6
+	VPSRLD       $0x08, X0, X5
+	VPCMPEQD     X2, X5, X5
+	VPADDD       X5, X6, X6
+	VPSRLD       $0x10, X0, X5
+	VPCMPEQD     X2, X5, X5
+	VPADDD       X5, X6, X6
+	VPADDD       X3, X6, X6
+	VPSLLVD      shift32<>+0(SB), X6, X6
+	VPOR         X6, X4, X4
+	VPCMPEQD     X2, X1, X6
+	VPSRLD       $0x08, X1, X5
+	VPCMPEQD     X2, X5, X5
+	VPADDD       X5, X6, X6
+	VPSRLD       $0x10, X1, X5
+	VPCMPEQD     X2, X5, X5
+	VPADDD       X5, X6, X6
+	VPADDD       X3, X6, X6
+	VPSLLVD      shift32<>+16(SB), X6, X6
+	VPOR         X6, X4, X4
+	VPSHUFD      $0x4e, X4, X5
+	VPOR         X5, X4, X4
+	VPSHUFD      $0xb1, X4, X5
+	VPOR         X5, X4, X4
+	VMOVD        X4, AX
+	MOVW         AX, r+72(FP)
+	MOVQ         shuffle+56(FP), CX
+	MOVBQZX      AL, DX
+	SHLQ         $0x04, DX
+	ADDQ         CX, DX
+	MOVWQZX      AX, BX
+	SHRQ         $0x08, BX
+	SHLQ         $0x04, BX
+	ADDQ         CX, BX
+	VPSHUFB      (DX), X0, X0
+	VPSHUFB      (BX), X1, X1
+	MOVQ         outBytes_base+24(FP), CX
+	MOVQ         CX, DX
+	MOVQ         lenTable+64(FP), BX
+	MOVBQZX      AL, AX
+	ADDQ         BX, AX
+	MOVBQZX      (AX), AX
+	ADDQ         AX, DX
+	VMOVDQU      X0, (CX)
+	VMOVDQU      X1, (DX)
+	RET
+
+// func Put8uint32SSEAsm(in []uint32, outBytes []byte, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint16)
+// Requires: SSE2, SSSE3
+TEXT ·Put8uint32SSEAsm(SB), NOSPLIT, $0-66
+	MOVQ     in_base+0(FP), AX
+	MOVOU    (AX), X0
+	MOVOU    16(AX), X1
+	MOVOU    mask0101x16<>+0(SB), X2
+	MOVOU    mask7F00x16<>+0(SB), X3
+	MOVOU    X0, X4
+	MOVOU    X1, X5
+	PMINUB   X2, X4
+	PMINUB   X2, X5
+	PACKUSWB X5, X4
+	PMINSW   X2, X4
+	PADDUSW  X3, X4
+	PMOVMSKB X4, AX
+	MOVW     AX, r+64(FP)
+	MOVQ     shuffle+48(FP), CX
+	MOVBQZX  AL, DX
+	SHLQ     $0x04, DX
+	ADDQ     CX, DX
+	MOVOU    (DX), X2
+	MOVWQZX  AX, DX
+	SHRQ     $0x08, DX
+	SHLQ     $0x04, DX
+	ADDQ     CX, DX
+	MOVOU    (DX), X3
+	PSHUFB   X2, X0
+	PSHUFB   X3, X1
+	MOVQ     outBytes_base+24(FP), CX
+	MOVQ     CX, DX
+	MOVQ     lenTable+56(FP), BX
+	MOVBQZX  AL, AX
+	ADDQ     BX, AX
+	MOVBQZX  (AX), AX
+	ADDQ     AX, DX
+	MOVOU    X0, (CX)
+	MOVOU    X1, (DX)
+	RET
+
+// func Put8uint32DeltaSSEAsm(in []uint32, outBytes []byte, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint16)
+// Requires: SSE, SSE2, SSSE3
+TEXT ·Put8uint32DeltaSSEAsm(SB), NOSPLIT, $0-74
+	MOVQ     in_base+0(FP), AX
+	MOVOU    (AX), X0
+	MOVOU    16(AX), X1
+	MOVOU    X1, X2
+	PALIGNR  $0x0c, X0, X2
+	PSUBL    X2, X1
+	MOVSS    prev+48(FP), X2
+	PSHUFD   $0x00, X2, X2
+	MOVOU    X0, X3
+	PALIGNR  $0x0c, X2, X3
+	PSUBL    X3, X0
+	MOVOU    mask0101x16<>+0(SB), X2
+	MOVOU    mask7F00x16<>+0(SB), X3
+	MOVOU    X0, X4
+	MOVOU    X1, X5
+	PMINUB   X2, X4
+	PMINUB   X2, X5
+	PACKUSWB X5, X4
+	PMINSW   X2, X4
+	PADDUSW  X3, X4
+	PMOVMSKB X4, AX
+	MOVW     AX, r+72(FP)
+	MOVQ     shuffle+56(FP), CX
+	MOVBQZX  AL, DX
+	SHLQ     $0x04, DX
+	ADDQ     CX, DX
+	MOVOU    (DX), X2
+	MOVWQZX  AX, DX
+	SHRQ     $0x08, DX
+	SHLQ     $0x04, DX
+	ADDQ     CX, DX
+	MOVOU    (DX), X3
+	PSHUFB   X2, X0
+	PSHUFB   X3, X1
+	MOVQ     outBytes_base+24(FP), CX
+	MOVQ     CX, DX
+	MOVQ     lenTable+64(FP), BX
+	MOVBQZX  AL, AX
+	ADDQ     BX, AX
+	MOVBQZX  (AX), AX
+	ADDQ     AX, DX
+	MOVOU    X0, (CX)
+	MOVOU    X1, (DX)
+	RET
+
+// func PutNuint32FastAsm(in []uint32, outBytes []byte, count int, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint8)
+// Requires: AVX, AVX2
+TEXT ·PutNuint32FastAsm(SB), NOSPLIT, $0-73
+	MOVQ         count+48(FP), AX
+	LEAQ         countMask<>+0(SB), CX
+	SHLQ         $0x04, AX
+	VMOVDQU      (CX)(AX*1), X0
+	MOVQ         in_base+0(FP), AX
+	VMASKMOVPS   (AX), X0, X0
+	VPXOR        X1, X1, X1
+	VPBROADCASTW mask0101<>+0(SB), X2
+	VPBROADCASTW mask7F00<>+0(SB), X3
+	VPMINUB      X2, X0, X4
+	VPMINUB      X2, X1, X1
+	VPACKUSWB    X1, X4, X4
+	VPMINSW      X2, X4, X4
+	VPADDUSW     X3, X4, X4
+	VPMOVMSKB    X4, AX
+	MOVB         AL, r+72(FP)
+	MOVQ         shuffle+56(FP), CX
+	MOVBQZX      AL, DX
+	SHLQ         $0x04, DX
+	ADDQ         CX, DX
+	VPSHUFB      (DX), X0, X0
+	MOVQ         lenTable+64(FP), CX
+	MOVBQZX      AL, AX
+	ADDQ         CX, AX
+	MOVBQZX      (AX), AX
+	MOVQ         count+48(FP), CX
+	ADDQ         CX, AX
+	SUBQ         $0x04, AX
+	MOVQ         outBytes_base+24(FP), CX
+	CMPQ         AX, $0x10
+	JE           store_full
+	VMOVQ        X0, DX
+	VPEXTRQ      $0x01, X0, BX
+	TESTQ        $0x00000008, AX
+	JE           store_skip8
+	MOVQ         DX, (CX)
+	ADDQ         $0x08, CX
+	MOVQ         BX, DX
+
+store_skip8:
+	TESTQ $0x00000004, AX
+	JE    store_skip4
+	MOVL  DX, (CX)
+	ADDQ  $0x04, CX
+	SHRQ  $0x20, DX
+
+store_skip4:
+	TESTQ $0x00000002, AX
+	JE    store_skip2
+	MOVW  DX, (CX)
+	ADDQ  $0x02, CX
+	SHRQ  $0x10, DX
+
+store_skip2:
+	TESTQ $0x00000001, AX
+	JE    store_done
+	MOVB  DL, (CX)
+	JMP   store_done
+
+store_full:
+	VMOVDQU X0, (CX)
+
+store_done:
+	RET
+
+// func PutNuint32DeltaFastAsm(in []uint32, outBytes []byte, count int, prev uint32, shuffle *[256][16]uint8, lenTable *[256]uint8) (r uint8)
+// Requires: AVX, AVX2
+TEXT ·PutNuint32DeltaFastAsm(SB), NOSPLIT, $0-81
+	MOVQ         count+48(FP), AX
+	LEAQ         countMask<>+0(SB), CX
+	SHLQ         $0x04, AX
+	VMOVDQU      (CX)(AX*1), X0
+	MOVQ         in_base+0(FP), AX
+	VMASKMOVPS   (AX), X0, X1
+	VBROADCASTSS prev+56(FP), X2
+	VPALIGNR     $0x0c, X2, X1, X2
+	VPSUBD       X2, X1, X1
+	VPAND        X0, X1, X1
+	VPXOR        X0, X0, X0
+	VPBROADCASTW mask0101<>+0(SB), X2
+	VPBROADCASTW mask7F00<>+0(SB), X3
+	VPMINUB      X2, X1, X4
+	VPMINUB      X2, X0, X0
+	VPACKUSWB    X0, X4, X4
+	VPMINSW      X2, X4, X4
+	VPADDUSW     X3, X4, X4
+	VPMOVMSKB    X4, AX
+	MOVB         AL, r+80(FP)
+	MOVQ         shuffle+64(FP), CX
+	MOVBQZX      AL, DX
+	SHLQ         $0x04, DX
+	ADDQ         CX, DX
+	VPSHUFB      (DX), X1, X1
+	MOVQ         lenTable+72(FP), CX
+	MOVBQZX      AL, AX
+	ADDQ         CX, AX
+	MOVBQZX      (AX), AX
+	MOVQ         count+48(FP), CX
+	ADDQ         CX, AX
+	SUBQ         $0x04, AX
+	MOVQ         outBytes_base+24(FP), CX
+	CMPQ         AX, $0x10
+	JE           store_full
+	VMOVQ        X1, DX
+	VPEXTRQ      $0x01, X1, BX
+	TESTQ        $0x00000008, AX
+	JE           store_skip8
+	MOVQ         DX, (CX)
+	ADDQ         $0x08, CX
+	MOVQ         BX, DX
+
+store_skip8:
+	TESTQ $0x00000004, AX
+	JE    store_skip4
+	MOVL  DX, (CX)
+	ADDQ  $0x04, CX
+	SHRQ  $0x20, DX
+
+store_skip4:
+	TESTQ $0x00000002, AX
+	JE    store_skip2
+	MOVW  DX, (CX)
+	ADDQ  $0x02, CX
+	SHRQ  $0x10, DX
+
+store_skip2:
+	TESTQ $0x00000001, AX
+	JE    store_done
+	MOVB  DL, (CX)
+	JMP   store_done
+
+store_full:
+	VMOVDQU X1, (CX)
+
+store_done:
+	RET
@@ -10,6 +10,16 @@ func GetMode() shared.PerformanceMode {
 	return shared.Normal
 }
 
+func detectTier() shared.Tier {
+	return shared.TierScalar
+}
+
+func defineFastOps() {}
+
+func selfTestKernels() []selfTestKernel {
+	return nil
+}
+
 func Put8uint32Fast(in []uint32, out []byte) uint16 {
 	panic("unreachable")
 }
@@ -17,3 +27,63 @@ func Put8uint32Fast(in []uint32, out []byte) uint16 {
 func Put8uint32DeltaFast(in []uint32, out []byte, prev uint32) uint16 {
 	panic("unreachable")
 }
+
+func Ctrl8uint32FastAsm(in []uint32) uint16 {
+	panic("unreachable")
+}
+
+func Ctrl8uint32DeltaFastAsm(in []uint32, prev uint32) uint16 {
+	panic("unreachable")
+}
+
+func Put8int32Fast(in []int32, out []byte) uint16 {
+	panic("unreachable")
+}
+
+func Put8uint64Fast(in []uint64, out []byte) uint16 {
+	panic("unreachable")
+}
+
+func Put8uint64DeltaFast(in []uint64, out []byte, prev uint64) uint16 {
+	panic("unreachable")
+}
+
+func Put8uint16Fast(in []uint16, out []byte) uint8 {
+	panic("unreachable")
+}
+
+func Put8uint16DeltaFast(in []uint16, out []byte, prev uint16) uint8 {
+	panic("unreachable")
+}
+
+func Put8uint32Fast0124(in []uint32, out []byte) uint16 {
+	panic("unreachable")
+}
+
+func Put8uint32DeltaFast0124(in []uint32, out []byte, prev uint32) uint16 {
+	panic("unreachable")
+}
+
+func Put8uint32SSE(in []uint32, out []byte) uint16 {
+	panic("unreachable")
+}
+
+func Put8uint32DeltaSSE(in []uint32, out []byte, prev uint32) uint16 {
+	panic("unreachable")
+}
+
+func Put4uint32Fast(in []uint32, out []byte) uint8 {
+	panic("unreachable")
+}
+
+func Put4uint32DeltaFast(in []uint32, out []byte, prev uint32) uint8 {
+	panic("unreachable")
+}
+
+func PutNuint32Fast(in []uint32, out []byte, count int) uint8 {
+	panic("unreachable")
+}
+
+func PutNuint32DeltaFast(in []uint32, out []byte, count int, prev uint32) uint8 {
+	panic("unreachable")
+}
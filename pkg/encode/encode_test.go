@@ -3,7 +3,10 @@ package encode
 import (
 	"encoding/binary"
 	"math/rand"
+	"os"
+	"os/exec"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -109,6 +112,389 @@ func TestPut8uint32DeltaFast(t *testing.T) {
 	}
 }
 
+func TestPut8uint32SSE(t *testing.T) {
+	if detectTier() < shared.TierSSE41 {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenUint32(count)
+
+	out := make([]byte, MaxBytesPerNum*count)
+	scalarCtrl := Put8uint32Scalar(nums, out)
+	out = out[:shared.ControlByteToSizeTwo(scalarCtrl)]
+
+	sseOut := make([]byte, MaxBytesPerNum*count)
+	sseCtrl := Put8uint32SSE(nums, sseOut)
+	sseOut = sseOut[:shared.ControlByteToSizeTwo(sseCtrl)]
+
+	if scalarCtrl != sseCtrl {
+		t.Fatalf("expected %#04x, actual %#04x, %+v", scalarCtrl, sseCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(out, sseOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", out, sseOut, nums)
+	}
+}
+
+func TestPut8uint32DeltaSSE(t *testing.T) {
+	if detectTier() < shared.TierSSE41 {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenUint32(count)
+	util.SortUint32(nums)
+	prev := nums[0] / 2
+
+	expectedData := make([]byte, MaxBytesPerNum*count)
+	scalarCtrl := Put8uint32DeltaScalar(nums, expectedData, prev)
+	expectedData = expectedData[:shared.ControlByteToSizeTwo(scalarCtrl)]
+
+	sseOut := make([]byte, MaxBytesPerNum*count)
+	sseCtrl := Put8uint32DeltaSSE(nums, sseOut, prev)
+	sseOut = sseOut[:shared.ControlByteToSizeTwo(sseCtrl)]
+
+	if scalarCtrl != sseCtrl {
+		t.Fatalf("expected %#04x, actual %#04x, %+v", scalarCtrl, sseCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(expectedData, sseOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", expectedData, sseOut, nums)
+	}
+}
+
+func TestSelfTest(t *testing.T) {
+	if os.Getenv("SELFTEST_FALLBACK") != "" {
+		// Running as the child process started below.
+		shared.Fallback("forced")
+		if GetTier() != shared.TierScalar || SupportedTier() != shared.TierScalar {
+			t.Fatalf("expected tier %v after fallback, got %v", shared.TierScalar, GetTier())
+		}
+		if detectTier() > shared.TierScalar && SetTier(detectTier()) == nil {
+			t.Fatalf("expected error setting tier %v after fallback", detectTier())
+		}
+		for _, impl := range shared.Implementations() {
+			if impl.Tier > shared.TierScalar && impl.Available && impl.Reason != "disabled: forced" {
+				t.Fatalf("unexpected reason for %s %s: %s", impl.Op, impl.Name, impl.Reason)
+			}
+		}
+		return
+	}
+
+	if err := SelfTest(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reason := shared.FallbackReason(); reason != "" {
+		t.Fatalf("unexpected fallback: %s", reason)
+	}
+
+	// Falling back is permanent, thus check it in a separate process,
+	// which also runs the self-test during initialization.
+	cmd := exec.Command(os.Args[0], "-test.run=^TestSelfTest$")
+	cmd.Env = append(os.Environ(), "SELFTEST_FALLBACK=1", shared.SelfTestEnv+"=1")
+	if out, err := cmd.CombinedOutput(); err != nil || !strings.Contains(string(out), "PASS") {
+		t.Fatalf("fallback check failed: %v\n%s", err, out)
+	}
+}
+
+func TestSetTier(t *testing.T) {
+	detected := detectTier()
+	defer SetTier(detected)
+	defer shared.SetMode(shared.GetMode())
+	shared.SetMode(shared.Fast)
+
+	if err := SetTier(detected + 1); err != shared.ErrUnsupportedTier {
+		t.Fatalf("expected %v, got %v", shared.ErrUnsupportedTier, err)
+	}
+
+	count := 8
+	nums := util.GenUint32(count)
+	expected := make([]byte, MaxBytesPerNum*count)
+	expectedCtrl := Put8uint32Scalar(nums, expected)
+	expected = expected[:shared.ControlByteToSizeTwo(expectedCtrl)]
+
+	for tier := shared.TierScalar; tier <= detected; tier++ {
+		if err := SetTier(tier); err != nil {
+			t.Fatalf("unexpected error setting tier %v: %v", tier, err)
+		}
+		if GetTier() != tier {
+			t.Fatalf("expected tier %v, got %v", tier, GetTier())
+		}
+
+		out := make([]byte, MaxBytesPerNum*count)
+		ctrl := Put8uint32(nums, out)
+		out = out[:shared.ControlByteToSizeTwo(ctrl)]
+		if ctrl != expectedCtrl || !reflect.DeepEqual(expected, out) {
+			t.Fatalf("tier %v: expected %+v, got %+v, %+v", tier, expected, out, nums)
+		}
+	}
+}
+
+func TestPut8int32Scalar(t *testing.T) {
+	in := []int32{0, -1, 1, -2, 2, -129, 128, -1_073_741_824}
+	zigzagged := []uint32{0, 1, 2, 3, 4, 257, 256, 2_147_483_647}
+
+	expected := make([]byte, 8*MaxBytesPerNum)
+	expectedCtrl := Put8uint32Scalar(zigzagged, expected)
+	expected = expected[:shared.ControlByteToSizeTwo(expectedCtrl)]
+
+	out := make([]byte, 8*MaxBytesPerNum)
+	actualCtrl := Put8int32Scalar(in, out)
+	if actualCtrl != expectedCtrl {
+		t.Fatalf("expected: %#016b, got %#016b, %+v", expectedCtrl, actualCtrl, in)
+	}
+
+	out = out[:shared.ControlByteToSizeTwo(actualCtrl)]
+	if !reflect.DeepEqual(expected, out) {
+		t.Fatalf("expected %+v, got %+v, %+v", expected, out, in)
+	}
+}
+
+func TestPut8int32Fast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenInt32(count)
+
+	out := make([]byte, MaxBytesPerNum*count)
+	scalarCtrl := Put8int32Scalar(nums, out)
+	out = out[:shared.ControlByteToSizeTwo(scalarCtrl)]
+
+	fastOut := make([]byte, MaxBytesPerNum*count)
+	fastCtrl := Put8int32Fast(nums, fastOut)
+	fastOut = fastOut[:shared.ControlByteToSizeTwo(fastCtrl)]
+
+	if scalarCtrl != fastCtrl {
+		t.Fatalf("expected %#04x, actual %#04x, %+v", scalarCtrl, fastCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(out, fastOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", out, fastOut, nums)
+	}
+}
+
+func TestPut8uint64Scalar(t *testing.T) {
+	in := []uint64{1024, 3, 1 << 40, 1, 1_073_741_824, 10, 12, 1024}
+	expectedData := []byte{
+		0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x01,
+		0x00, 0x00, 0x00, 0x40, 0x0a, 0x0c, 0x00, 0x04,
+	}
+
+	expectedCtrl := uint16(0b01_00_00_10_00_11_00_01)
+	out := make([]byte, 64)
+	actualCtrl := Put8uint64Scalar(in, out)
+	if actualCtrl != expectedCtrl {
+		t.Fatalf("expected: %#016b, got %#016b, %+v", expectedCtrl, actualCtrl, in)
+	}
+
+	actualData := out[:shared.ControlByteToSizeTwo64(actualCtrl)]
+	if !reflect.DeepEqual(expectedData, actualData) {
+		t.Fatalf("expected %+v, got %+v, %+v", expectedData, actualData, in)
+	}
+}
+
+func TestPut8uint64Fast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenUint64(count)
+
+	out := make([]byte, MaxBytesPerNum64*count)
+	scalarCtrl := Put8uint64Scalar(nums, out)
+	out = out[:shared.ControlByteToSizeTwo64(scalarCtrl)]
+
+	fastOut := make([]byte, MaxBytesPerNum64*count)
+	fastCtrl := Put8uint64Fast(nums, fastOut)
+	fastOut = fastOut[:shared.ControlByteToSizeTwo64(fastCtrl)]
+
+	if scalarCtrl != fastCtrl {
+		t.Fatalf("expected %#04x, actual %#04x, %+v", scalarCtrl, fastCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(out, fastOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", out, fastOut, nums)
+	}
+}
+
+func TestPut8uint64DeltaFast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenUint64(count)
+	util.SortUint64(nums)
+	prev := nums[0] / 2
+
+	expectedData := make([]byte, MaxBytesPerNum64*count)
+	scalarCtrl := Put8uint64DeltaScalar(nums, expectedData, prev)
+	expectedData = expectedData[:shared.ControlByteToSizeTwo64(scalarCtrl)]
+
+	fastOut := make([]byte, MaxBytesPerNum64*count)
+	fastCtrl := Put8uint64DeltaFast(nums, fastOut, prev)
+	fastOut = fastOut[:shared.ControlByteToSizeTwo64(fastCtrl)]
+
+	if scalarCtrl != fastCtrl {
+		t.Fatalf("expected %#04x, actual %#04x, %+v", scalarCtrl, fastCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(expectedData, fastOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", expectedData, fastOut, nums)
+	}
+}
+
+func TestPut8uint16Scalar(t *testing.T) {
+	in := []uint16{1024, 3, 2, 1, 65535, 10, 12, 256}
+	expectedData := []byte{
+		0x00, 0x04, 0x03, 0x02, 0x01, 0xff, 0xff, 0x0a, 0x0c, 0x00, 0x01,
+	}
+
+	expectedCtrl := uint8(0b1001_0001)
+	out := make([]byte, 16)
+	actualCtrl := Put8uint16Scalar(in, out)
+	if actualCtrl != expectedCtrl {
+		t.Fatalf("expected: %#08b, got %#08b, %+v", expectedCtrl, actualCtrl, in)
+	}
+
+	actualData := out[:shared.ControlByteToSize16(actualCtrl)]
+	if !reflect.DeepEqual(expectedData, actualData) {
+		t.Fatalf("expected %+v, got %+v, %+v", expectedData, actualData, in)
+	}
+}
+
+func TestPut8uint16Fast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenUint16(count)
+
+	out := make([]byte, MaxBytesPerNum16*count)
+	scalarCtrl := Put8uint16Scalar(nums, out)
+	out = out[:shared.ControlByteToSize16(scalarCtrl)]
+
+	fastOut := make([]byte, MaxBytesPerNum16*count)
+	fastCtrl := Put8uint16Fast(nums, fastOut)
+	fastOut = fastOut[:shared.ControlByteToSize16(fastCtrl)]
+
+	if scalarCtrl != fastCtrl {
+		t.Fatalf("expected %#02x, actual %#02x, %+v", scalarCtrl, fastCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(out, fastOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", out, fastOut, nums)
+	}
+}
+
+func TestPut8uint16DeltaFast(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenUint16(count)
+	util.SortUint16(nums)
+	prev := nums[0] / 2
+
+	expectedData := make([]byte, MaxBytesPerNum16*count)
+	scalarCtrl := Put8uint16DeltaScalar(nums, expectedData, prev)
+	expectedData = expectedData[:shared.ControlByteToSize16(scalarCtrl)]
+
+	fastOut := make([]byte, MaxBytesPerNum16*count)
+	fastCtrl := Put8uint16DeltaFast(nums, fastOut, prev)
+	fastOut = fastOut[:shared.ControlByteToSize16(fastCtrl)]
+
+	if scalarCtrl != fastCtrl {
+		t.Fatalf("expected %#02x, actual %#02x, %+v", scalarCtrl, fastCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(expectedData, fastOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", expectedData, fastOut, nums)
+	}
+}
+
+func TestPut8uint32Scalar0124(t *testing.T) {
+	in := []uint32{0, 111, 1234, 789123, 0, 0, 256, 1}
+	expectedData := []byte{
+		0x6f, 0xd2, 0x04, 0x83, 0x0a, 0x0c, 0x00, 0x00, 0x01, 0x01,
+	}
+
+	expectedCtrl := uint16(0b01_10_00_00_11_10_01_00)
+	out := make([]byte, 32)
+	actualCtrl := Put8uint32Scalar0124(in, out)
+	if actualCtrl != expectedCtrl {
+		t.Fatalf("expected: %#016b, got %#016b, %+v", expectedCtrl, actualCtrl, in)
+	}
+
+	actualData := out[:shared.ControlByteToSizeTwo0124(actualCtrl)]
+	if !reflect.DeepEqual(expectedData, actualData) {
+		t.Fatalf("expected %+v, got %+v, %+v", expectedData, actualData, in)
+	}
+}
+
+func TestPut8uint32Fast0124(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenUint32(count)
+	for i := range nums {
+		if rand.Intn(2) == 0 {
+			nums[i] = 0
+		}
+	}
+
+	out := make([]byte, MaxBytesPerNum*count)
+	scalarCtrl := Put8uint32Scalar0124(nums, out)
+	out = out[:shared.ControlByteToSizeTwo0124(scalarCtrl)]
+
+	fastOut := make([]byte, MaxBytesPerNum*count)
+	fastCtrl := Put8uint32Fast0124(nums, fastOut)
+	fastOut = fastOut[:shared.ControlByteToSizeTwo0124(fastCtrl)]
+
+	if scalarCtrl != fastCtrl {
+		t.Fatalf("expected %#04x, actual %#04x, %+v", scalarCtrl, fastCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(out, fastOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", out, fastOut, nums)
+	}
+}
+
+func TestPut8uint32DeltaFast0124(t *testing.T) {
+	if GetMode() == shared.Normal {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	count := 8
+	nums := util.GenUint32(count)
+	util.SortUint32(nums)
+	nums[1] = nums[0]
+
+	expectedData := make([]byte, MaxBytesPerNum*count)
+	scalarCtrl := Put8uint32DeltaScalar0124(nums, expectedData, 0)
+	expectedData = expectedData[:shared.ControlByteToSizeTwo0124(scalarCtrl)]
+
+	fastOut := make([]byte, MaxBytesPerNum*count)
+	fastCtrl := Put8uint32DeltaFast0124(nums, fastOut, 0)
+	fastOut = fastOut[:shared.ControlByteToSizeTwo0124(fastCtrl)]
+
+	if scalarCtrl != fastCtrl {
+		t.Fatalf("expected %#04x, actual %#04x, %+v", scalarCtrl, fastCtrl, nums)
+	}
+
+	if !reflect.DeepEqual(expectedData, fastOut) {
+		t.Fatalf("expected %+v, got %+v, %+v", expectedData, fastOut, nums)
+	}
+}
+
 func TestPutUint32Scalar(t *testing.T) {
 	count := rand.Intn(4) + 1
 	nums := util.GenUint32(count)
@@ -159,6 +545,61 @@ func TestPutUint32DeltaScalar(t *testing.T) {
 	}
 }
 
+func TestPutNuint32Fast(t *testing.T) {
+	if detectTier() < shared.TierAVX2 {
+		t.Skipf("Testing environment doesn't support this test")
+	}
+
+	for _, v := range shared.SelfTestVectors() {
+		for count := 1; count <= 4; count++ {
+			for _, delta := range []bool{false, true} {
+				testPutNuint32Fast(t, v.Nums[4-count:], count, v.Prev, delta)
+			}
+		}
+	}
+}
+
+// testPutNuint32Fast compares the encoding of the first count integers of
+// nums against the scalar one and makes sure that nothing is written past
+// it. The integers past count must not affect the encoding either.
+func testPutNuint32Fast(t *testing.T, nums []uint32, count int, prev uint32, delta bool) {
+	t.Helper()
+
+	expected := make([]byte, 4*MaxBytesPerNum)
+	var expectedCtrl, ctrl uint8
+	if delta {
+		expectedCtrl = PutUint32DeltaScalar(nums, expected, count, prev)
+	} else {
+		expectedCtrl = PutUint32Scalar(nums, expected, count)
+	}
+	size := shared.ControlByteToSize(expectedCtrl) - (4 - count)
+	expected = expected[:size]
+
+	out := make([]byte, 4*MaxBytesPerNum+1)
+	for i := range out {
+		out[i] = 0xa5
+	}
+	if delta {
+		ctrl = PutNuint32DeltaFast(nums, out, count, prev)
+	} else {
+		ctrl = PutNuint32Fast(nums, out, count)
+	}
+
+	if ctrl != expectedCtrl {
+		t.Fatalf("expected %#02x, actual %#02x, %+v[:%d]", expectedCtrl, ctrl, nums, count)
+	}
+
+	if !reflect.DeepEqual(expected, out[:size]) {
+		t.Fatalf("expected %+v, got %+v, %+v[:%d]", expected, out[:size], nums, count)
+	}
+
+	for i, b := range out[size:] {
+		if b != 0xa5 {
+			t.Fatalf("byte %d past the encoding overwritten, %+v[:%d]", size+i, nums, count)
+		}
+	}
+}
+
 var writeSinkA uint16
 
 func BenchmarkPut8uint32Fast(b *testing.B) {
@@ -11,13 +11,28 @@ import (
 )
 
 const (
-	name      = "Put8uint32FastAsm"
-	nameDelta = "Put8uint32DeltaFastAsm"
+	name          = "Put8uint32FastAsm"
+	nameDelta     = "Put8uint32DeltaFastAsm"
+	nameCtrl      = "Ctrl8uint32FastAsm"
+	nameCtrlDelta = "Ctrl8uint32DeltaFastAsm"
+	nameZigzag    = "Put8int32FastAsm"
+	name64        = "Put8uint64FastAsm"
+	nameDelta64   = "Put8uint64DeltaFastAsm"
+	name16        = "Put8uint16FastAsm"
+	nameDelta16   = "Put8uint16DeltaFastAsm"
+	name0124      = "Put8uint32FastAsm0124"
+	nameDelta0124 = "Put8uint32DeltaFastAsm0124"
+	nameSSE       = "Put8uint32SSEAsm"
+	nameDeltaSSE  = "Put8uint32DeltaSSEAsm"
+	nameN         = "PutNuint32FastAsm"
+	nameDeltaN    = "PutNuint32DeltaFastAsm"
+
 	pIn       = "in"
 	pOut      = "outBytes"
 	pShuffle  = "shuffle"
 	pLenTable = "lenTable"
 	pPrev     = "prev"
+	pCount    = "count"
 	pR        = "r"
 )
 
@@ -30,19 +45,115 @@ var (
 		"func(%s []uint32, %s []byte, %s uint32, %s *[256][16]uint8, %s *[256]uint8) (%s uint16)",
 		pIn, pOut, pPrev, pShuffle, pLenTable, pR)
 
+	signatureZigzag = fmt.Sprintf(
+		"func(%s []int32, %s []byte, %s *[256][16]uint8, %s *[256]uint8) (%s uint16)",
+		pIn, pOut, pShuffle, pLenTable, pR)
+
+	signature64 = fmt.Sprintf(
+		"func(%s []uint64, %s []byte, %s *[16][16]uint8, %s *[16]uint8) (%s uint16)",
+		pIn, pOut, pShuffle, pLenTable, pR)
+
+	signatureDelta64 = fmt.Sprintf(
+		"func(%s []uint64, %s []byte, %s uint64, %s *[16][16]uint8, %s *[16]uint8) (%s uint16)",
+		pIn, pOut, pPrev, pShuffle, pLenTable, pR)
+
+	signature16 = fmt.Sprintf(
+		"func(%s []uint16, %s []byte, %s *[256][16]uint8) (%s uint8)",
+		pIn, pOut, pShuffle, pR)
+
+	signatureDelta16 = fmt.Sprintf(
+		"func(%s []uint16, %s []byte, %s uint16, %s *[256][16]uint8) (%s uint8)",
+		pIn, pOut, pPrev, pShuffle, pR)
+
+	signatureN = fmt.Sprintf(
+		"func(%s []uint32, %s []byte, %s int, %s *[256][16]uint8, %s *[256]uint8) (%s uint8)",
+		pIn, pOut, pCount, pShuffle, pLenTable, pR)
+
+	signatureDeltaN = fmt.Sprintf(
+		"func(%s []uint32, %s []byte, %s int, %s uint32, %s *[256][16]uint8, %s *[256]uint8) (%s uint8)",
+		pIn, pOut, pCount, pPrev, pShuffle, pLenTable, pR)
+
+	signatureCtrl = fmt.Sprintf("func(%s []uint32) (%s uint16)", pIn, pR)
+
+	signatureCtrlDelta = fmt.Sprintf("func(%s []uint32, %s uint32) (%s uint16)", pIn, pPrev, pR)
+
 	mask1111R = ConstData("mask0101", operand.U16(0x0101))
 	mask7F00R = ConstData("mask7F00", operand.U16(0x7F00))
+	mask03R   = ConstData("mask03", operand.U64(3))
+	shift64R  = shiftTable64()
+	mask03DR  = ConstData("mask03D", operand.U32(3))
+	shift32R  = shiftTable32()
+
+	mask1111x16R = broadcastTable16("mask0101x16", 0x0101)
+	mask7F00x16R = broadcastTable16("mask7F00x16", 0x7F00)
+
+	countMaskR = shared.CountMaskTable("countMask")
 )
 
 func main() {
 	regular()
 	differential()
+	ctrlRegular()
+	ctrlDifferential()
+	zigzag()
+	regular64()
+	differential64()
+	regular16()
+	differential16()
+	regular0124()
+	differential0124()
+	regularSSE()
+	differentialSSE()
+	regularN()
+	differentialN()
 	Generate()
 }
 
 func differential() {
 	TEXT(nameDelta, NOSPLIT, signatureDelta)
+	coreAlgorithm(loadDelta())
+}
+
+func regular() {
+	TEXT(name, NOSPLIT, signature)
+	coreAlgorithm(shared.Load8(pIn))
+}
+
+func zigzag() {
+	TEXT(nameZigzag, NOSPLIT, signatureZigzag)
+
+	firstFour, secondFour := shared.Load8(pIn)
+	zigzagEncode(firstFour)
+	zigzagEncode(secondFour)
+	coreAlgorithm(firstFour, secondFour)
+}
+
+// zigzagEncode maps the signed integers in four onto unsigned
+// integers so that values with a small magnitude stay small.
+func zigzagEncode(four reg.VecVirtual) {
+	shifted := XMM()                      // [A B C D]
+	VPSLLD(operand.Imm(1), four, shifted) // [A<<1 B<<1 C<<1 D<<1]
+	VPSRAD(operand.Imm(31), four, four)   // [A>>31 B>>31 C>>31 D>>31]
+	VPXOR(shifted, four, four)            // [(A<<1)^(A>>31) ...]
+}
+
+func ctrlRegular() {
+	TEXT(nameCtrl, NOSPLIT, signatureCtrl)
+	ctrl := control(shared.Load8(pIn))
+	Store(ctrl.As16(), Return(pR))
+	RET()
+}
+
+func ctrlDifferential() {
+	TEXT(nameCtrlDelta, NOSPLIT, signatureCtrlDelta)
+	ctrl := control(loadDelta())
+	Store(ctrl.As16(), Return(pR))
+	RET()
+}
 
+// loadDelta loads 8 uint32s from the input and converts them
+// into deltas using the prev parameter as the base.
+func loadDelta() (reg.VecVirtual, reg.VecVirtual) {
 	prevSingular, err := Param(pPrev).Resolve()
 	if err != nil {
 		log.Fatalf("failed to get addr of prev")
@@ -57,15 +168,12 @@ func differential() {
 	VPALIGNR(operand.Imm(12), prev, firstFour, prev)
 	VPSUBD(prev, firstFour, firstFour)
 
-	coreAlgorithm(firstFour, secondFour)
-}
-
-func regular() {
-	TEXT(name, NOSPLIT, signature)
-	coreAlgorithm(shared.Load8(pIn))
+	return firstFour, secondFour
 }
 
-func coreAlgorithm(firstFour, secondFour reg.VecVirtual) {
+// control generates the 16-bit control for the 8 uint32s held in
+// the two provided registers. The registers are left untouched.
+func control(firstFour, secondFour reg.VecVirtual) reg.GPVirtual {
 	onesMask := XMM()
 	sevenFzerozero := XMM()
 	VPBROADCASTW(mask1111R, onesMask)
@@ -83,6 +191,16 @@ func coreAlgorithm(firstFour, secondFour reg.VecVirtual) {
 
 	ctrl := GP32()
 	VPMOVMSKB(minFirstFour, ctrl)
+	return ctrl
+}
+
+func coreAlgorithm(firstFour, secondFour reg.VecVirtual) {
+	shuffleAndStore(control(firstFour, secondFour), firstFour, secondFour)
+}
+
+// shuffleAndStore compresses the 8 uint32s held in the two provided
+// registers according to ctrl and writes them to the output.
+func shuffleAndStore(ctrl reg.GPVirtual, firstFour, secondFour reg.VecVirtual) {
 	Store(ctrl.As16(), Return(pR))
 
 	shuffleBase := Load(Param(pShuffle), GP64())
@@ -106,3 +224,422 @@ func coreAlgorithm(firstFour, secondFour reg.VecVirtual) {
 
 	RET()
 }
+
+// shiftTable64 declares the per uint64 shift counts used to move each
+// 2-bit code into its position within the 16-bit control.
+func shiftTable64() operand.Mem {
+	table := GLOBL("shift64", RODATA|NOPTR)
+	for i := 0; i < 8; i++ {
+		DATA(8*i, operand.U64(2*i))
+	}
+	return table
+}
+
+func regular64() {
+	TEXT(name64, NOSPLIT, signature64)
+	coreAlgorithm64(load8x64())
+}
+
+func differential64() {
+	TEXT(nameDelta64, NOSPLIT, signatureDelta64)
+
+	prevSingular, err := Param(pPrev).Resolve()
+	if err != nil {
+		log.Fatalf("failed to get addr of prev")
+	}
+
+	pairs := load8x64() // [A B] [C D] [E F] [G H]
+	shifted := XMM()
+	for i := len(pairs) - 1; i > 0; i-- {
+		VPALIGNR(operand.Imm(8), pairs[i-1], pairs[i], shifted) // [B C]
+		VPSUBQ(shifted, pairs[i], pairs[i])                     // [C-B D-C]
+	}
+
+	VPBROADCASTQ(prevSingular.Addr, shifted)             // [P P]
+	VPALIGNR(operand.Imm(8), shifted, pairs[0], shifted) // [P A]
+	VPSUBQ(shifted, pairs[0], pairs[0])                  // [A-P B-A]
+
+	coreAlgorithm64(pairs)
+}
+
+// load8x64 loads 8 uint64s from the input into four registers each
+// holding a pair of integers.
+func load8x64() [4]reg.VecVirtual {
+	arrBase := operand.Mem{
+		Base: Load(Param(pIn).Base(), GP64()),
+	}
+
+	var pairs [4]reg.VecVirtual
+	for i := range pairs {
+		pairs[i] = XMM()
+		VLDDQU(arrBase.Offset(16*i), pairs[i])
+	}
+	return pairs
+}
+
+// control64 generates the 16-bit control for the 8 uint64s held in the
+// provided registers. The registers are left untouched. The 2-bit code
+// of every integer is the count of the right shifts by 8, 16 and 32
+// bits that leave a non-zero value behind:
+//
+// Input:           [A B]
+// Zero masks:      [A>>8==0 B>>8==0] + [A>>16==0 B>>16==0] + ...
+// Add 3:           [codeA codeB]
+// Variable shift:  [codeA<<2i codeB<<2(i+1)]
+func control64(pairs [4]reg.VecVirtual) reg.GPVirtual {
+	zero := XMM()
+	three := XMM()
+	acc := XMM()
+	VPXOR(zero, zero, zero)
+	VPXOR(acc, acc, acc)
+	VPBROADCASTQ(mask03R, three)
+
+	shifted := XMM()
+	code := XMM()
+	for i, pair := range pairs {
+		VPSRLQ(operand.Imm(8), pair, shifted)
+		VPCMPEQQ(zero, shifted, code)
+		for _, bits := range []uint64{16, 32} {
+			VPSRLQ(operand.Imm(bits), pair, shifted)
+			VPCMPEQQ(zero, shifted, shifted)
+			VPADDQ(shifted, code, code)
+		}
+
+		VPADDQ(three, code, code)
+		VPSLLVQ(shift64R.Offset(16*i), code, code)
+		VPOR(code, acc, acc)
+	}
+
+	VPSHUFD(operand.Imm(0x4e), acc, shifted)
+	VPOR(shifted, acc, acc)
+
+	ctrl := GP64()
+	VMOVQ(acc, ctrl)
+	return ctrl
+}
+
+func coreAlgorithm64(pairs [4]reg.VecVirtual) {
+	ctrl := control64(pairs)
+	Store(ctrl.As16(), Return(pR))
+
+	shuffleBase := Load(Param(pShuffle), GP64())
+	lenBase := Load(Param(pLenTable), GP64())
+	outAddr := Load(Param(pOut).Base(), GP64())
+
+	nibble := GP64()
+	lenValue := GP64()
+	for i, pair := range pairs {
+		MOVQ(ctrl, nibble)
+		if i > 0 {
+			SHRQ(operand.Imm(uint64(4*i)), nibble)
+		}
+		ANDQ(operand.Imm(0xf), nibble)
+		MOVBQZX(operand.Mem{Base: lenBase, Index: nibble, Scale: 1}, lenValue)
+
+		// Left shift by 4 to get the byte level offset for the shuffle table
+		SHLQ(operand.Imm(4), nibble)
+		VPSHUFB(operand.Mem{Base: shuffleBase, Index: nibble, Scale: 1}, pair, pair)
+		VMOVDQU(pair, operand.Mem{Base: outAddr})
+		if i < len(pairs)-1 {
+			ADDQ(lenValue, outAddr)
+		}
+	}
+
+	RET()
+}
+
+func regular16() {
+	TEXT(name16, NOSPLIT, signature16)
+	coreAlgorithm16(load8x16())
+}
+
+func differential16() {
+	TEXT(nameDelta16, NOSPLIT, signatureDelta16)
+
+	eight := load8x16() // [A B C D E F G H]
+	prev := XMM()
+	VMOVD(Load(Param(pPrev), GP32()), prev)      // [P - - - - - - -]
+	VPSLLDQ(operand.Imm(14), prev, prev)         // [- - - - - - - P]
+	VPALIGNR(operand.Imm(14), prev, eight, prev) // [P A B C D E F G]
+	VPSUBW(prev, eight, eight)                   // [A-P B-A ... H-G]
+
+	coreAlgorithm16(eight)
+}
+
+// load8x16 loads 8 uint16s from the input into a single register.
+func load8x16() reg.VecVirtual {
+	eight := XMM()
+	VLDDQU(operand.Mem{Base: Load(Param(pIn).Base(), GP64())}, eight)
+	return eight
+}
+
+// coreAlgorithm16 generates the 8-bit control for the 8 uint16s held in
+// eight and writes out the compressed integers. An integer needs two bytes
+// exactly when its upper byte is non-zero:
+//
+// Input:           [A B C D E F G H]
+// Shift right:     [A>>8 B>>8 ...]
+// Compare zero:    [A>>8==0 B>>8==0 ...]
+// Pack and mask:   ^[a b c d e f g h]
+func coreAlgorithm16(eight reg.VecVirtual) {
+	zero := XMM()
+	mask := XMM()
+	VPXOR(zero, zero, zero)
+	VPSRLW(operand.Imm(8), eight, mask)
+	VPCMPEQW(zero, mask, mask)
+	VPACKSSWB(mask, mask, mask)
+
+	ctrl := GP32()
+	VPMOVMSKB(mask, ctrl)
+	NOTL(ctrl)
+	Store(ctrl.As8(), Return(pR))
+
+	shuffleBase := Load(Param(pShuffle), GP64())
+	shuffle := GP64()
+	MOVBQZX(ctrl.As8(), shuffle)
+
+	// Left shift by 4 to get the byte level offset for the shuffle table
+	SHLQ(operand.Imm(4), shuffle)
+	ADDQ(shuffleBase, shuffle)
+	VPSHUFB(operand.Mem{Base: shuffle}, eight, eight)
+
+	VMOVDQU(eight, operand.Mem{Base: Load(Param(pOut).Base(), GP64())})
+	RET()
+}
+
+// shiftTable32 declares the per uint32 shift counts used to move each
+// 2-bit code into its position within the 16-bit control.
+func shiftTable32() operand.Mem {
+	table := GLOBL("shift32", RODATA|NOPTR)
+	for i := 0; i < 8; i++ {
+		DATA(4*i, operand.U32(2*i))
+	}
+	return table
+}
+
+func regular0124() {
+	TEXT(name0124, NOSPLIT, signature)
+	firstFour, secondFour := shared.Load8(pIn)
+	shuffleAndStore(control0124(firstFour, secondFour), firstFour, secondFour)
+}
+
+func differential0124() {
+	TEXT(nameDelta0124, NOSPLIT, signatureDelta)
+	firstFour, secondFour := loadDelta()
+	shuffleAndStore(control0124(firstFour, secondFour), firstFour, secondFour)
+}
+
+// control0124 generates the 16-bit control of the "0124" variant for the
+// 8 uint32s held in the two provided registers. The registers are left
+// untouched. The 2-bit code of every integer is the count of the right
+// shifts by 0, 8 and 16 bits that leave a non-zero value behind:
+//
+// Input:           [A B C D]
+// Zero masks:      [A==0 B==0 ...] + [A>>8==0 B>>8==0 ...] + ...
+// Add 3:           [codeA codeB codeC codeD]
+// Variable shift:  [codeA<<2i codeB<<2(i+1) ...]
+func control0124(firstFour, secondFour reg.VecVirtual) reg.GPVirtual {
+	zero := XMM()
+	three := XMM()
+	acc := XMM()
+	VPXOR(zero, zero, zero)
+	VPXOR(acc, acc, acc)
+	VPBROADCASTD(mask03DR, three)
+
+	shifted := XMM()
+	code := XMM()
+	for i, four := range []reg.VecVirtual{firstFour, secondFour} {
+		VPCMPEQD(zero, four, code)
+		for _, bits := range []uint64{8, 16} {
+			VPSRLD(operand.Imm(bits), four, shifted)
+			VPCMPEQD(zero, shifted, shifted)
+			VPADDD(shifted, code, code)
+		}
+
+		VPADDD(three, code, code)
+		VPSLLVD(shift32R.Offset(16*i), code, code)
+		VPOR(code, acc, acc)
+	}
+
+	VPSHUFD(operand.Imm(0x4e), acc, shifted)
+	VPOR(shifted, acc, acc)
+	VPSHUFD(operand.Imm(0xb1), acc, shifted)
+	VPOR(shifted, acc, acc)
+
+	ctrl := GP32()
+	VMOVD(acc, ctrl)
+	return ctrl
+}
+
+// broadcastTable16 declares a 16 byte table holding the provided 16-bit
+// value in every lane. The legacy SSE kernels cannot rely on AVX2 for
+// broadcasting it.
+func broadcastTable16(name string, value uint16) operand.Mem {
+	table := GLOBL(name, RODATA|NOPTR)
+	for i := 0; i < 8; i++ {
+		DATA(2*i, operand.U16(value))
+	}
+	return table
+}
+
+// The SSE kernels below are legacy (non VEX encoded) counterparts of the
+// regular and differential kernels for CPUs without AVX2. They only rely
+// on SSSE3 for PSHUFB and PALIGNR. Legacy SSE instructions require their
+// memory operands to be aligned, thus everything is loaded into registers
+// with MOVOU first.
+
+func regularSSE() {
+	TEXT(nameSSE, NOSPLIT, signature)
+	coreAlgorithmSSE(load8SSE())
+}
+
+func differentialSSE() {
+	TEXT(nameDeltaSSE, NOSPLIT, signatureDelta)
+	coreAlgorithmSSE(loadDeltaSSE())
+}
+
+func load8SSE() (reg.VecVirtual, reg.VecVirtual) {
+	inBase := operand.Mem{Base: Load(Param(pIn).Base(), GP64())}
+	firstFour, secondFour := XMM(), XMM()
+	MOVOU(inBase, firstFour)
+	MOVOU(inBase.Offset(16), secondFour)
+	return firstFour, secondFour
+}
+
+// loadDeltaSSE works similarly to loadDelta using legacy SSE
+// instructions.
+func loadDeltaSSE() (reg.VecVirtual, reg.VecVirtual) {
+	prevSingular, err := Param(pPrev).Resolve()
+	if err != nil {
+		log.Fatalf("failed to get addr of prev")
+	}
+
+	firstFour, secondFour := load8SSE()
+	prev := XMM()
+	MOVOU(secondFour, prev)
+	PALIGNR(operand.Imm(12), firstFour, prev) // [D E F G]
+	PSUBL(prev, secondFour)
+
+	MOVSS(prevSingular.Addr, prev)
+	PSHUFD(operand.Imm(0), prev, prev) // [P P P P]
+	shifted := XMM()
+	MOVOU(firstFour, shifted)
+	PALIGNR(operand.Imm(12), prev, shifted) // [P A B C]
+	PSUBL(shifted, firstFour)
+
+	return firstFour, secondFour
+}
+
+// controlSSE works similarly to control using legacy SSE instructions.
+func controlSSE(firstFour, secondFour reg.VecVirtual) reg.GPVirtual {
+	onesMask := XMM()
+	sevenFzerozero := XMM()
+	MOVOU(mask1111x16R, onesMask)
+	MOVOU(mask7F00x16R, sevenFzerozero)
+
+	minFirstFour := XMM()
+	minSecondFour := XMM()
+	MOVOU(firstFour, minFirstFour)
+	MOVOU(secondFour, minSecondFour)
+	PMINUB(onesMask, minFirstFour)
+	PMINUB(onesMask, minSecondFour)
+
+	PACKUSWB(minSecondFour, minFirstFour)
+	PMINSW(onesMask, minFirstFour)
+	PADDUSW(sevenFzerozero, minFirstFour)
+
+	ctrl := GP32()
+	PMOVMSKB(minFirstFour, ctrl)
+	return ctrl
+}
+
+// coreAlgorithmSSE works similarly to coreAlgorithm using legacy SSE
+// instructions.
+func coreAlgorithmSSE(firstFour, secondFour reg.VecVirtual) {
+	ctrl := controlSSE(firstFour, secondFour)
+	Store(ctrl.As16(), Return(pR))
+
+	shuffleBase := Load(Param(pShuffle), GP64())
+	firstShuffle, secondShuffle := XMM(), XMM()
+	MOVOU(shared.CalculateShuffleAddrFromCtrl(shuffleBase, ctrl, false), firstShuffle)
+	MOVOU(shared.CalculateShuffleAddrFromCtrl(shuffleBase, ctrl, true), secondShuffle)
+
+	PSHUFB(firstShuffle, firstFour)
+	PSHUFB(secondShuffle, secondFour)
+
+	firstAddr := Load(Param(pOut).Base(), GP64())
+	secondAddr := GP64()
+	MOVQ(firstAddr, secondAddr)
+
+	lenAddr, lenValue := shared.LenValueAddr(ctrl, false, pLenTable)
+	MOVBQZX(lenAddr, lenValue)
+	ADDQ(lenValue, secondAddr)
+
+	MOVOU(firstFour, operand.Mem{Base: firstAddr})
+	MOVOU(secondFour, operand.Mem{Base: secondAddr})
+
+	RET()
+}
+
+// The N kernels below encode the first count, 1 to 4, uint32s of the
+// input, e.g. the last ones of a stream. They neither read past the count
+// integers nor write past their encoding. The remaining lanes are zeroed
+// so that their 2-bit codes are zero and their single byte is dropped
+// when storing.
+
+func regularN() {
+	TEXT(nameN, NOSPLIT, signatureN)
+	four, _ := loadN()
+	coreAlgorithmN(four)
+}
+
+func differentialN() {
+	TEXT(nameDeltaN, NOSPLIT, signatureDeltaN)
+
+	prevSingular, err := Param(pPrev).Resolve()
+	if err != nil {
+		log.Fatalf("failed to get addr of prev")
+	}
+
+	four, mask := loadN()
+	prev := XMM()
+	VBROADCASTSS(prevSingular.Addr, prev)       // [P P P P]
+	VPALIGNR(operand.Imm(12), prev, four, prev) // [P A B C]
+	VPSUBD(prev, four, four)                    // [A-P B-A C-B D-C]
+	VPAND(mask, four, four)                     // [A-P B-A 0 0]
+	coreAlgorithmN(four)
+}
+
+// loadN loads the first count uint32s from the input using a masked
+// load, which zeroes the remaining lanes without touching their memory.
+// It returns them along with the mask selecting their lanes.
+func loadN() (reg.VecVirtual, reg.VecVirtual) {
+	count := Load(Param(pCount), GP64())
+	mask := shared.LoadCountMask(countMaskR, count)
+
+	four := XMM()
+	inBase := operand.Mem{Base: Load(Param(pIn).Base(), GP64())}
+	VMASKMOVPS(inBase, mask, four)
+	return four, mask
+}
+
+// coreAlgorithmN generates the 8-bit control for the uint32s held in four
+// and writes out the compressed integers, minus the single byte of every
+// zeroed lane past count.
+func coreAlgorithmN(four reg.VecVirtual) {
+	zero := XMM()
+	VPXOR(zero, zero, zero)
+	ctrl := control(four, zero)
+	Store(ctrl.As8(), Return(pR))
+
+	shuffleBase := Load(Param(pShuffle), GP64())
+	VPSHUFB(shared.CalculateShuffleAddrFromCtrl(shuffleBase, ctrl, false), four, four)
+
+	lenAddr, size := shared.LenValueAddr(ctrl, false, pLenTable)
+	MOVBQZX(lenAddr, size)
+	ADDQ(Load(Param(pCount), GP64()), size)
+	SUBQ(operand.Imm(4), size)
+
+	shared.StorePartial(four, Load(Param(pOut).Base(), GP64()), size, "store")
+	RET()
+}
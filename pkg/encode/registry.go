@@ -0,0 +1,38 @@
+package encode
+
+import "github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+
+// Ops of this package that kernels can be registered for using
+// shared.Register. The func of a kernel must have the same signature as the
+// scalar implementation of its op, e.g. Put8uint32Scalar for OpPut8uint32.
+const (
+	OpPut8uint32                 = "encode.Put8uint32"
+	OpPut8uint32Delta            = "encode.Put8uint32Delta"
+	OpPut8int32                  = "encode.Put8int32"
+	OpPut8uint64                 = "encode.Put8uint64"
+	OpPut8uint64Delta            = "encode.Put8uint64Delta"
+	OpPut8uint16                 = "encode.Put8uint16"
+	OpPut8uint16Delta            = "encode.Put8uint16Delta"
+	OpPut8uint32Variant0124      = "encode.Put8uint32Variant0124"
+	OpPut8uint32DeltaVariant0124 = "encode.Put8uint32DeltaVariant0124"
+)
+
+// defineOps defines the ops of this package along with their built-in
+// kernels.
+func defineOps() {
+	scalar := func(fn interface{}) shared.Kernel {
+		return shared.Kernel{Name: shared.KernelScalar, Tier: shared.TierScalar, Func: fn}
+	}
+
+	shared.Define(OpPut8uint32, scalar(Put8uint32Scalar))
+	shared.Define(OpPut8uint32Delta, scalar(Put8uint32DeltaScalar))
+	shared.Define(OpPut8int32, scalar(Put8int32Scalar))
+	shared.Define(OpPut8uint64, scalar(Put8uint64Scalar))
+	shared.Define(OpPut8uint64Delta, scalar(Put8uint64DeltaScalar))
+	shared.Define(OpPut8uint16, scalar(Put8uint16Scalar))
+	shared.Define(OpPut8uint16Delta, scalar(Put8uint16DeltaScalar))
+	shared.Define(OpPut8uint32Variant0124, scalar(Put8uint32Scalar0124))
+	shared.Define(OpPut8uint32DeltaVariant0124, scalar(Put8uint32DeltaScalar0124))
+
+	defineFastOps()
+}
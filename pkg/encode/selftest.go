@@ -0,0 +1,48 @@
+package encode
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// selfTestKernel is an accelerated kernel checked by SelfTest.
+type selfTestKernel struct {
+	name      string
+	put8      func(in []uint32, out []byte) uint16
+	put8Delta func(in []uint32, out []byte, prev uint32) uint16
+}
+
+// SelfTest checks the accelerated Put8uint32 and Put8uint32Delta kernels
+// supported by the CPU against the known vectors of
+// shared.SelfTestVectors. If any of them produces a different encoding, it
+// permanently falls back to the portable Go implementation using
+// shared.Fallback and returns an error wrapping shared.ErrSelfTest. It is
+// run during package initialization if opted into using
+// shared.SelfTestEnv, and must not be called concurrently with any other
+// func of this package otherwise.
+func SelfTest() error {
+	for _, k := range selfTestKernels() {
+		for _, v := range shared.SelfTestVectors() {
+			out := make([]byte, 8*MaxBytesPerNum)
+			ctrl := k.put8(v.Nums, out)
+			if ctrl != v.Ctrl || !bytes.Equal(out[:len(v.Data)], v.Data) {
+				return selfTestFailed(k.name, "Put8uint32", v.Nums)
+			}
+
+			out = make([]byte, 8*MaxBytesPerNum)
+			ctrl = k.put8Delta(v.Nums, out, v.Prev)
+			if ctrl != v.DeltaCtrl || !bytes.Equal(out[:len(v.DeltaData)], v.DeltaData) {
+				return selfTestFailed(k.name, "Put8uint32Delta", v.Nums)
+			}
+		}
+	}
+	return nil
+}
+
+func selfTestFailed(kernel, fn string, nums []uint32) error {
+	err := fmt.Errorf("%w: %s %s kernel encoded %v wrongly", shared.ErrSelfTest, kernel, fn, nums)
+	shared.Fallback(err.Error())
+	return err
+}
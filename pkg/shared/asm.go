@@ -47,3 +47,161 @@ func Load8(paramName string) (reg.VecVirtual, reg.VecVirtual) {
 
 	return firstFour, secondFour
 }
+
+// CountMaskTable declares a table of 5 rows of 16 bytes. The row i
+// selects the first i uint32 lanes of a register, e.g. for VMASKMOVPS.
+func CountMaskTable(name string) operand.Mem {
+	table := GLOBL(name, RODATA|NOPTR)
+	for i := 0; i <= 4; i++ {
+		for j := 0; j < 4; j++ {
+			var lane uint32
+			if j < i {
+				lane = 0xffffffff
+			}
+			DATA(16*i+4*j, operand.U32(lane))
+		}
+	}
+	return table
+}
+
+// LoadCountMask loads the row of the table declared by CountMaskTable
+// selecting the first count uint32 lanes. The count register is left
+// untouched.
+func LoadCountMask(table operand.Mem, count reg.Register) reg.VecVirtual {
+	base := GP64()
+	offset := GP64()
+	LEAQ(table, base)
+	MOVQ(count, offset)
+
+	// Left shift by 4 to get the byte level offset for the row
+	SHLQ(operand.Imm(4), offset)
+
+	mask := XMM()
+	VMOVDQU(operand.Mem{Base: base, Index: offset, Scale: 1}, mask)
+	return mask
+}
+
+// LoadPartial loads the n bytes, 1 to 16, at addr into the lowest bytes
+// of a register and zeroes the remaining ones. Unlike VLDDQU, it never
+// reads past the n bytes: the last n&7 bytes are gathered using 4, 2 and
+// 1 byte loads. Labels are prefixed with prefix to keep them unique.
+func LoadPartial(addr, n reg.Register, prefix string) reg.VecVirtual {
+	var (
+		full   = prefix + "_full"
+		skip1  = prefix + "_skip1"
+		skip2  = prefix + "_skip2"
+		skip4  = prefix + "_skip4"
+		low    = prefix + "_low"
+		insert = prefix + "_insert"
+		done   = prefix + "_done"
+	)
+
+	x := XMM()
+	CMPQ(n, operand.Imm(16))
+	JE(operand.LabelRef(full))
+
+	lo, hi, tail := GP64(), GP64(), GP64()
+	XORQ(hi, hi)
+	XORQ(tail, tail)
+
+	// The last n&7 bytes start at addr+n&8
+	tailAddr := GP64()
+	MOVQ(n, tailAddr)
+	ANDQ(operand.Imm(8), tailAddr)
+	ADDQ(addr, tailAddr)
+
+	offset, value := GP64(), GP64()
+	TESTQ(operand.U32(1), n)
+	JE(operand.LabelRef(skip1))
+	MOVQ(n, offset)
+	ANDQ(operand.Imm(6), offset)
+	MOVBQZX(operand.Mem{Base: tailAddr, Index: offset, Scale: 1}, tail)
+	Label(skip1)
+
+	TESTQ(operand.U32(2), n)
+	JE(operand.LabelRef(skip2))
+	MOVQ(n, offset)
+	ANDQ(operand.Imm(4), offset)
+	MOVWQZX(operand.Mem{Base: tailAddr, Index: offset, Scale: 1}, value)
+	SHLQ(operand.Imm(16), tail)
+	ORQ(value, tail)
+	Label(skip2)
+
+	TESTQ(operand.U32(4), n)
+	JE(operand.LabelRef(skip4))
+	MOVL(operand.Mem{Base: tailAddr}, value.As32())
+	SHLQ(operand.Imm(32), tail)
+	ORQ(value, tail)
+	Label(skip4)
+
+	TESTQ(operand.U32(8), n)
+	JE(operand.LabelRef(low))
+	MOVQ(operand.Mem{Base: addr}, lo)
+	MOVQ(tail, hi)
+	JMP(operand.LabelRef(insert))
+	Label(low)
+	MOVQ(tail, lo)
+	Label(insert)
+
+	VMOVQ(lo, x)
+	VPINSRQ(operand.Imm(1), hi, x, x)
+	JMP(operand.LabelRef(done))
+
+	Label(full)
+	VMOVDQU(operand.Mem{Base: addr}, x)
+	Label(done)
+
+	return x
+}
+
+// StorePartial stores the n lowest bytes, 1 to 16, of x at addr. Unlike
+// VMOVDQU, it never writes past the n bytes: the last n&7 bytes are
+// scattered using 4, 2 and 1 byte stores. Labels are prefixed with prefix
+// to keep them unique.
+func StorePartial(x reg.VecVirtual, addr, n reg.Register, prefix string) {
+	var (
+		full  = prefix + "_full"
+		skip8 = prefix + "_skip8"
+		skip4 = prefix + "_skip4"
+		skip2 = prefix + "_skip2"
+		done  = prefix + "_done"
+	)
+
+	CMPQ(n, operand.Imm(16))
+	JE(operand.LabelRef(full))
+
+	lo, hi, p := GP64(), GP64(), GP64()
+	VMOVQ(x, lo)
+	VPEXTRQ(operand.Imm(1), x, hi)
+	MOVQ(addr, p)
+
+	TESTQ(operand.U32(8), n)
+	JE(operand.LabelRef(skip8))
+	MOVQ(lo, operand.Mem{Base: p})
+	ADDQ(operand.Imm(8), p)
+	MOVQ(hi, lo)
+	Label(skip8)
+
+	TESTQ(operand.U32(4), n)
+	JE(operand.LabelRef(skip4))
+	MOVL(lo.As32(), operand.Mem{Base: p})
+	ADDQ(operand.Imm(4), p)
+	SHRQ(operand.Imm(32), lo)
+	Label(skip4)
+
+	TESTQ(operand.U32(2), n)
+	JE(operand.LabelRef(skip2))
+	MOVW(lo.As16(), operand.Mem{Base: p})
+	ADDQ(operand.Imm(2), p)
+	SHRQ(operand.Imm(16), lo)
+	Label(skip2)
+
+	TESTQ(operand.U32(1), n)
+	JE(operand.LabelRef(done))
+	MOVB(lo.As8(), operand.Mem{Base: p})
+	JMP(operand.LabelRef(done))
+
+	Label(full)
+	VMOVDQU(x, operand.Mem{Base: addr})
+	Label(done)
+}
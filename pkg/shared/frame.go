@@ -0,0 +1,20 @@
+package shared
+
+// The framed format is used to stream Stream VByte encoded integers through
+// an io.Writer and io.Reader. It starts with a single flags byte followed by
+// the uvarint encoded initial prev value used for differential coding. A
+// sequence of blocks follows, each made up of the uvarint count of integers
+// in the block, the uvarint byte length of the block and the block itself,
+// encoded as a regular Stream VByte stream.
+const (
+	// FrameFlagDelta indicates that the blocks of a framed stream are
+	// differentially coded, where the prev of each block is the last
+	// integer of the previous block.
+	FrameFlagDelta = 1 << 0
+
+	// DefaultFrameBlockLen is the default count of integers per block.
+	DefaultFrameBlockLen = 1 << 12
+
+	// MaxFrameBlockLen is the largest count of integers allowed in a block.
+	MaxFrameBlockLen = 1 << 16
+)
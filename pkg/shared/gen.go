@@ -9,3 +9,23 @@ func ControlByteToSize(in uint8) int {
 func ControlByteToSizeTwo(in uint16) int {
 	return int(PerControlLenTable[in&0xff] + PerControlLenTable[in>>8])
 }
+
+func ControlByteToSize64(in uint8) int {
+	return int(PerControlLenTable64[in])
+}
+
+func ControlByteToSizeTwo64(in uint16) int {
+	return int(PerControlLenTable64[in&0xff]) + int(PerControlLenTable64[in>>8])
+}
+
+func ControlByteToSize16(in uint8) int {
+	return int(PerControlLenTable16[in])
+}
+
+func ControlByteToSize0124(in uint8) int {
+	return int(PerControlLenTable0124[in])
+}
+
+func ControlByteToSizeTwo0124(in uint16) int {
+	return int(PerControlLenTable0124[in&0xff] + PerControlLenTable0124[in>>8])
+}
@@ -0,0 +1,210 @@
+package shared
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultIndexInterval is the default count of integers between two
+	// checkpoints of an Index.
+	DefaultIndexInterval = 1 << 12
+
+	// MaxIndexInterval is the largest count of integers between two
+	// checkpoints of an Index, which is a multiple of 4 fitting into an
+	// int on every platform.
+	MaxIndexInterval = math.MaxInt32 &^ 3
+
+	indexFlagDelta = 1 << 0
+
+	// maxIndexHeader bounds the header fields of a serialized Index so
+	// that deriving the number of checkpoints cannot overflow.
+	maxIndexHeader = 1 << 48
+)
+
+// ErrCorruptIndex indicates that a serialized Index is malformed.
+var ErrCorruptIndex = errors.New("streamvbyte: corrupt index")
+
+// Index is a sidecar skip index over a regular Stream VByte stream. It
+// records a checkpoint every Interval integers holding the offset of the
+// checkpoint's first data byte and, for differentially coded streams, the
+// absolute value preceding it, which is all that is needed to start
+// decoding the stream from that checkpoint.
+//
+// An Index is never modified once built, thus it is safe to share across
+// goroutines for reading.
+type Index struct {
+	// Count is the count of integers in the indexed stream.
+	Count int
+	// Interval is the count of integers between two checkpoints. It is
+	// always a positive multiple of 4 so that every checkpoint starts at
+	// a control byte.
+	Interval int
+	// Delta reports whether the indexed stream is differentially coded.
+	Delta bool
+	// Offsets holds the offset of the first data byte of every checkpoint,
+	// relative to the start of the data bytes.
+	Offsets []int
+	// Prevs holds the absolute value preceding every checkpoint for
+	// differentially coded streams, i.e. Prevs[0] is the prev of the
+	// stream itself. It is nil otherwise.
+	Prevs []uint32
+}
+
+// BuildIndex builds an Index over the regular Stream VByte stream holding
+// count integers by summing up the lengths described by its control bytes.
+// It only records data offsets, thus the Prevs of a differentially coded
+// stream must be filled in by the caller. An interval less than 1 selects
+// DefaultIndexInterval, one greater than MaxIndexInterval is capped and any
+// other is rounded up to a multiple of 4.
+func BuildIndex(count int, stream []byte, interval int) *Index {
+	if interval < 1 {
+		interval = DefaultIndexInterval
+	}
+	if interval > MaxIndexInterval {
+		interval = MaxIndexInterval
+	}
+	interval = (interval + 3) &^ 3
+
+	var (
+		ctrls   = stream[:(count+3)/4]
+		offsets = make([]int, 0, (count+interval-1)/interval)
+		offset  = 0
+	)
+	for pos := 0; pos < count; pos += 4 {
+		if pos%interval == 0 {
+			offsets = append(offsets, offset)
+		}
+		offset += int(PerControlLenTable[ctrls[pos/4]])
+	}
+
+	return &Index{
+		Count:    count,
+		Interval: interval,
+		Offsets:  offsets,
+	}
+}
+
+// Lookup returns the position of the last checkpoint at or before the
+// integer at position i, which must be less than Count, along with the
+// offset of its first data byte and, for differentially coded streams, the
+// value preceding it.
+func (x *Index) Lookup(i int) (pos, offset int, prev uint32) {
+	k := i / x.Interval
+	if x.Delta {
+		prev = x.Prevs[k]
+	}
+	return k * x.Interval, x.Offsets[k], prev
+}
+
+// MarshalBinary encodes the Index into a byte slice. The encoding is a
+// flags byte followed by the uvarint encoded count, interval and number of
+// checkpoints, the uvarint encoded differences between consecutive
+// offsets and, for differentially coded streams, the little endian prevs.
+func (x *Index) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 1, 1+3*binary.MaxVarintLen64+len(x.Offsets)*(2+4))
+	if x.Delta {
+		out[0] |= indexFlagDelta
+	}
+
+	var buf [binary.MaxVarintLen64]byte
+	for _, v := range []int{x.Count, x.Interval, len(x.Offsets)} {
+		out = append(out, buf[:binary.PutUvarint(buf[:], uint64(v))]...)
+	}
+
+	last := 0
+	for _, offset := range x.Offsets {
+		out = append(out, buf[:binary.PutUvarint(buf[:], uint64(offset-last))]...)
+		last = offset
+	}
+
+	if x.Delta {
+		for _, prev := range x.Prevs {
+			binary.LittleEndian.PutUint32(buf[:], prev)
+			out = append(out, buf[:4]...)
+		}
+	}
+
+	return out, nil
+}
+
+// UnmarshalBinary decodes an Index previously encoded by MarshalBinary. It
+// makes sure that the offsets are consistent with the interval, i.e. that
+// every interval takes up between 1 and 4 bytes per integer, but it cannot
+// tell whether the Index was built over a given stream. An Index from an
+// untrusted source must thus only be used with streams whose control bytes
+// were checked to match its offsets, e.g. by comparing it with BuildIndex.
+func (x *Index) UnmarshalBinary(data []byte) error {
+	if len(data) < 1 {
+		return fmt.Errorf("%w: empty", ErrCorruptIndex)
+	}
+	flags := data[0]
+	if flags&^indexFlagDelta != 0 {
+		return fmt.Errorf("%w: unknown flags %#02x", ErrCorruptIndex, flags)
+	}
+	data = data[1:]
+
+	var header [3]int
+	for i := range header {
+		v, n := binary.Uvarint(data)
+		if n <= 0 || v > maxIndexHeader {
+			return fmt.Errorf("%w: bad header", ErrCorruptIndex)
+		}
+		header[i] = int(v)
+		data = data[n:]
+	}
+
+	count, interval, checkpoints := header[0], header[1], header[2]
+	if interval < 4 || interval > MaxIndexInterval || interval&3 != 0 || checkpoints != (count+interval-1)/interval {
+		return fmt.Errorf("%w: bad header", ErrCorruptIndex)
+	}
+	if checkpoints > len(data) {
+		return fmt.Errorf("%w: short offsets", ErrCorruptIndex)
+	}
+
+	// Every checkpoint but the first follows a whole interval of integers
+	// taking up between 1 and 4 bytes each, so the offsets are strictly
+	// increasing.
+	offsets := make([]int, checkpoints)
+	last := 0
+	for i := range offsets {
+		lo, hi := uint64(interval), uint64(interval)*4
+		if i == 0 {
+			lo, hi = 0, 0
+		}
+		v, n := binary.Uvarint(data)
+		if n <= 0 || v < lo || v > hi {
+			return fmt.Errorf("%w: bad offset %d", ErrCorruptIndex, i)
+		}
+		last += int(v)
+		offsets[i] = last
+		data = data[n:]
+	}
+
+	var prevs []uint32
+	if flags&indexFlagDelta != 0 {
+		if len(data) != 4*checkpoints {
+			return fmt.Errorf("%w: bad prevs", ErrCorruptIndex)
+		}
+		prevs = make([]uint32, checkpoints)
+		for i := range prevs {
+			prevs[i] = binary.LittleEndian.Uint32(data[4*i:])
+		}
+		data = data[4*checkpoints:]
+	}
+
+	if len(data) != 0 {
+		return fmt.Errorf("%w: trailing bytes", ErrCorruptIndex)
+	}
+
+	*x = Index{
+		Count:    count,
+		Interval: interval,
+		Delta:    flags&indexFlagDelta != 0,
+		Offsets:  offsets,
+		Prevs:    prevs,
+	}
+	return nil
+}
@@ -18,7 +18,10 @@ var (
 	fPackage = flag.String("package", "shared", "package name")
 )
 
-const MaxControlByte = 1 << 8
+const (
+	MaxControlByte = 1 << 8
+	MaxPairNibble  = 1 << 4
+)
 
 func main() {
 	flag.Parse()
@@ -32,20 +35,60 @@ func main() {
 	_, _ = fmt.Fprintln(out, "// Code generated by gentables. DO NOT EDIT.")
 	_, _ = fmt.Fprintf(out, "\npackage %s\n", *fPackage)
 
-	if err := genPerNumLengthTable(out); err != nil {
-		log.Fatalf("failed to gen per num length table")
+	for _, variant := range []struct {
+		suffix string
+		sizes  sizesFunc
+	}{
+		{"", sizes},
+		{"0124", sizes0124},
+	} {
+		if err := genPerNumLengthTable(out, variant.suffix, variant.sizes); err != nil {
+			log.Fatalf("failed to gen per num length table")
+		}
+
+		if err := genPerQuadLengthTable(out, variant.suffix, variant.sizes); err != nil {
+			log.Fatalf("failed to gen sum length table")
+		}
+
+		if err := genEncodeShuffleTable(out, variant.suffix, variant.sizes); err != nil {
+			log.Fatalf("failed to gen encode shuffle table")
+		}
+
+		if err := genDecodeShuffleTable(out, variant.suffix, variant.sizes); err != nil {
+			log.Fatalf("failed to gen decode shuffle table")
+		}
 	}
 
-	if err := genPerQuadLengthTable(out); err != nil {
-		log.Fatalf("failed to gen sum length table")
+	if err := genPerNumLengthTable64(out); err != nil {
+		log.Fatalf("failed to gen 64-bit per num length table")
 	}
 
-	if err := genEncodeShuffleTable(out); err != nil {
-		log.Fatalf("failed to gen encode shuffle table")
+	if err := genPerQuadLengthTable64(out); err != nil {
+		log.Fatalf("failed to gen 64-bit sum length table")
 	}
 
-	if err := genDecodeShuffleTable(out); err != nil {
-		log.Fatalf("failed to gen decode shuffle table")
+	if err := genPerPairLengthTable64(out); err != nil {
+		log.Fatalf("failed to gen 64-bit pair length table")
+	}
+
+	if err := genEncodeShuffleTable64(out); err != nil {
+		log.Fatalf("failed to gen 64-bit encode shuffle table")
+	}
+
+	if err := genDecodeShuffleTable64(out); err != nil {
+		log.Fatalf("failed to gen 64-bit decode shuffle table")
+	}
+
+	if err := genPerControlLengthTable16(out); err != nil {
+		log.Fatalf("failed to gen 16-bit sum length table")
+	}
+
+	if err := genEncodeShuffleTable16(out); err != nil {
+		log.Fatalf("failed to gen 16-bit encode shuffle table")
+	}
+
+	if err := genDecodeShuffleTable16(out); err != nil {
+		log.Fatalf("failed to gen 16-bit decode shuffle table")
 	}
 
 	final, err := format.Source(out.Bytes())
@@ -77,8 +120,8 @@ func newLineAfter(countPerLine int) func(out io.Writer) {
 	}
 }
 
-func genPerNumLengthTable(out io.Writer) error {
-	_, _ = fmt.Fprintf(out, "\nvar PerNumLenTable *[256][4]uint8 = &[256][4]uint8{\n")
+func genPerNumLengthTable(out io.Writer, suffix string, sizes sizesFunc) error {
+	_, _ = fmt.Fprintf(out, "\nvar PerNumLenTable%s *[256][4]uint8 = &[256][4]uint8{\n", suffix)
 	tabber := newLineAfter(4)
 	for i := 0; i < MaxControlByte; i++ {
 		one, two, three, four := sizes(uint8(i))
@@ -92,8 +135,8 @@ func genPerNumLengthTable(out io.Writer) error {
 	return nil
 }
 
-func genPerQuadLengthTable(out io.Writer) error {
-	_, _ = fmt.Fprintf(out, "\nvar PerControlLenTable *[256]uint8 = &[256]uint8{\n")
+func genPerQuadLengthTable(out io.Writer, suffix string, sizes sizesFunc) error {
+	_, _ = fmt.Fprintf(out, "\nvar PerControlLenTable%s *[256]uint8 = &[256]uint8{\n", suffix)
 	tabber := newLineAfter(8)
 	for i := 0; i < MaxControlByte; i++ {
 		one, two, three, four := sizes(uint8(i))
@@ -112,8 +155,8 @@ const (
 	commentStr    = "\t// %d\t%#02x\t%08b\tlen\t%d\t%d\t%d\t%d\n"
 )
 
-func genEncodeShuffleTable(out io.Writer) error {
-	_, _ = fmt.Fprintf(out, "\nvar EncodeShuffleTable *[256][16]uint8 = &[256][16]uint8{\n")
+func genEncodeShuffleTable(out io.Writer, suffix string, sizes sizesFunc) error {
+	_, _ = fmt.Fprintf(out, "\nvar EncodeShuffleTable%s *[256][16]uint8 = &[256][16]uint8{\n", suffix)
 	tabber := newLineAfter(1)
 	for i := 0; i < MaxControlByte; i++ {
 		one, two, three, four := sizes(uint8(i))
@@ -145,8 +188,8 @@ func genEncodeShuffleTable(out io.Writer) error {
 	return nil
 }
 
-func genDecodeShuffleTable(out io.Writer) error {
-	_, _ = fmt.Fprintf(out, "\nvar DecodeShuffleTable *[256][16]uint8 = &[256][16]uint8{\n")
+func genDecodeShuffleTable(out io.Writer, suffix string, sizes sizesFunc) error {
+	_, _ = fmt.Fprintf(out, "\nvar DecodeShuffleTable%s *[256][16]uint8 = &[256][16]uint8{\n", suffix)
 	tabber := newLineAfter(1)
 	for i := 0; i < MaxControlByte; i++ {
 		one, two, three, four := sizes(uint8(i))
@@ -180,6 +223,10 @@ func genDecodeShuffleTable(out io.Writer) error {
 	return nil
 }
 
+// sizesFunc returns the length in bytes for each of the four numbers
+// represented by the provided control byte.
+type sizesFunc func(control uint8) (one uint8, two uint8, three uint8, four uint8)
+
 // sizes returns the length in bytes for each of the four numbers
 // represented by the provided control byte.
 func sizes(control uint8) (one uint8, two uint8, three uint8, four uint8) {
@@ -189,3 +236,246 @@ func sizes(control uint8) (one uint8, two uint8, three uint8, four uint8) {
 	four = (control >> 6 & 3) + 1
 	return
 }
+
+// sizes0124 returns the length in bytes for each of the four numbers
+// represented by the provided control byte using the "0124" variant,
+// where the 2-bit codes map onto lengths of 0, 1, 2 and 4 bytes.
+func sizes0124(control uint8) (one uint8, two uint8, three uint8, four uint8) {
+	lens := [4]uint8{0, 1, 2, 4}
+	return lens[control&3], lens[control>>2&3], lens[control>>4&3], lens[control>>6&3]
+}
+
+// The 64-bit variant uses the same 2-bit codes as the 32-bit variant,
+// however they map onto lengths of 1, 2, 4 and 8 bytes. The SIMD
+// kernels operate on pairs of uint64s, so alongside the per control
+// byte tables there are tables indexed by a 4-bit nibble that describe
+// a single pair.
+
+func genPerNumLengthTable64(out io.Writer) error {
+	_, _ = fmt.Fprintf(out, "\nvar PerNumLenTable64 *[256][4]uint8 = &[256][4]uint8{\n")
+	tabber := newLineAfter(4)
+	for i := 0; i < MaxControlByte; i++ {
+		one, two, three, four := sizes64(uint8(i))
+		_, err := fmt.Fprintf(out, "\t{%d, %d, %d, %d},", one, two, three, four)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write per num len: %d", i)
+		}
+		tabber(out)
+	}
+	_, _ = fmt.Fprintln(out, "}")
+	return nil
+}
+
+func genPerQuadLengthTable64(out io.Writer) error {
+	_, _ = fmt.Fprintf(out, "\nvar PerControlLenTable64 *[256]uint8 = &[256]uint8{\n")
+	tabber := newLineAfter(8)
+	for i := 0; i < MaxControlByte; i++ {
+		one, two, three, four := sizes64(uint8(i))
+		_, err := fmt.Fprintf(out, "\t%d,", one+two+three+four)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write summed len: %d", i)
+		}
+		tabber(out)
+	}
+	_, _ = fmt.Fprintln(out, "}")
+	return nil
+}
+
+func genPerPairLengthTable64(out io.Writer) error {
+	_, _ = fmt.Fprintf(out, "\nvar PerPairLenTable64 *[16]uint8 = &[16]uint8{\n")
+	tabber := newLineAfter(8)
+	for i := 0; i < MaxPairNibble; i++ {
+		one, two, _, _ := sizes64(uint8(i))
+		_, err := fmt.Fprintf(out, "\t%d,", one+two)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write pair len: %d", i)
+		}
+		tabber(out)
+	}
+	_, _ = fmt.Fprintln(out, "}")
+	return nil
+}
+
+const commentStr64 = "\t// %d\t%#02x\t%04b\tlen\t%d\t%d\n"
+
+func genEncodeShuffleTable64(out io.Writer) error {
+	_, _ = fmt.Fprintf(out, "\nvar EncodeShuffleTable64 *[16][16]uint8 = &[16][16]uint8{\n")
+	tabber := newLineAfter(1)
+	for i := 0; i < MaxPairNibble; i++ {
+		one, two, _, _ := sizes64(uint8(i))
+		_, _ = fmt.Fprintf(out, commentStr64, i, i, i, one, two)
+		_, err := fmt.Fprintf(out, "\t{")
+		if err != nil {
+			return errors.Wrapf(err, "failed to write encode shuffle table")
+		}
+
+		var positions []interface{}
+		var base uint8
+		for _, size := range []uint8{one, two} {
+			for j := uint8(0); j < size; j++ {
+				positions = append(positions, base+j)
+			}
+			base += 8
+		}
+
+		for len(positions) < 16 {
+			positions = append(positions, 0xff)
+		}
+		_, err = fmt.Fprintf(out, shuffleFmtStr, positions...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write per num len: %d", i)
+		}
+		tabber(out)
+	}
+	_, _ = fmt.Fprintln(out, "}")
+	return nil
+}
+
+func genDecodeShuffleTable64(out io.Writer) error {
+	_, _ = fmt.Fprintf(out, "\nvar DecodeShuffleTable64 *[16][16]uint8 = &[16][16]uint8{\n")
+	tabber := newLineAfter(1)
+	for i := 0; i < MaxPairNibble; i++ {
+		one, two, _, _ := sizes64(uint8(i))
+		_, _ = fmt.Fprintf(out, commentStr64, i, i, i, one, two)
+		_, err := fmt.Fprintf(out, "\t{")
+		if err != nil {
+			return errors.Wrapf(err, "failed to write decode shuffle table")
+		}
+
+		var positions []interface{}
+		var pos uint8
+		for _, size := range []uint8{one, two} {
+			for j := 0; j < 8; j++ {
+				if size > 0 {
+					positions = append(positions, pos)
+					pos++
+					size--
+				} else {
+					positions = append(positions, 0xff)
+				}
+			}
+		}
+
+		_, err = fmt.Fprintf(out, shuffleFmtStr, positions...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write per num len: %d", i)
+		}
+		tabber(out)
+	}
+	_, _ = fmt.Fprintln(out, "}")
+	return nil
+}
+
+// sizes64 returns the length in bytes for each of the four uint64s
+// represented by the provided control byte.
+func sizes64(control uint8) (one uint8, two uint8, three uint8, four uint8) {
+	return 1 << (control & 3), 1 << (control >> 2 & 3), 1 << (control >> 4 & 3), 1 << (control >> 6 & 3)
+}
+
+// The 16-bit variant uses a 1-bit code per integer that maps onto lengths
+// of 1 and 2 bytes, thus a single control byte describes 8 integers.
+
+func genPerControlLengthTable16(out io.Writer) error {
+	_, _ = fmt.Fprintf(out, "\nvar PerControlLenTable16 *[256]uint8 = &[256]uint8{\n")
+	tabber := newLineAfter(8)
+	for i := 0; i < MaxControlByte; i++ {
+		total := 0
+		for _, size := range sizes16(uint8(i)) {
+			total += int(size)
+		}
+		_, err := fmt.Fprintf(out, "\t%d,", total)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write summed len: %d", i)
+		}
+		tabber(out)
+	}
+	_, _ = fmt.Fprintln(out, "}")
+	return nil
+}
+
+const commentStr16 = "\t// %d\t%#02x\t%08b\tlen\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n"
+
+func genEncodeShuffleTable16(out io.Writer) error {
+	_, _ = fmt.Fprintf(out, "\nvar EncodeShuffleTable16 *[256][16]uint8 = &[256][16]uint8{\n")
+	tabber := newLineAfter(1)
+	for i := 0; i < MaxControlByte; i++ {
+		sizes := sizes16(uint8(i))
+		comment := []interface{}{i, i, i}
+		for _, size := range sizes {
+			comment = append(comment, size)
+		}
+		_, _ = fmt.Fprintf(out, commentStr16, comment...)
+		_, err := fmt.Fprintf(out, "\t{")
+		if err != nil {
+			return errors.Wrapf(err, "failed to write encode shuffle table")
+		}
+
+		var positions []interface{}
+		var base uint8
+		for _, size := range sizes {
+			for j := uint8(0); j < size; j++ {
+				positions = append(positions, base+j)
+			}
+			base += 2
+		}
+
+		for len(positions) < 16 {
+			positions = append(positions, 0xff)
+		}
+		_, err = fmt.Fprintf(out, shuffleFmtStr, positions...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write per num len: %d", i)
+		}
+		tabber(out)
+	}
+	_, _ = fmt.Fprintln(out, "}")
+	return nil
+}
+
+func genDecodeShuffleTable16(out io.Writer) error {
+	_, _ = fmt.Fprintf(out, "\nvar DecodeShuffleTable16 *[256][16]uint8 = &[256][16]uint8{\n")
+	tabber := newLineAfter(1)
+	for i := 0; i < MaxControlByte; i++ {
+		sizes := sizes16(uint8(i))
+		comment := []interface{}{i, i, i}
+		for _, size := range sizes {
+			comment = append(comment, size)
+		}
+		_, _ = fmt.Fprintf(out, commentStr16, comment...)
+		_, err := fmt.Fprintf(out, "\t{")
+		if err != nil {
+			return errors.Wrapf(err, "failed to write decode shuffle table")
+		}
+
+		var positions []interface{}
+		var pos uint8
+		for _, size := range sizes {
+			positions = append(positions, pos)
+			pos++
+			if size == 2 {
+				positions = append(positions, pos)
+				pos++
+			} else {
+				positions = append(positions, 0xff)
+			}
+		}
+
+		_, err = fmt.Fprintf(out, shuffleFmtStr, positions...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write per num len: %d", i)
+		}
+		tabber(out)
+	}
+	_, _ = fmt.Fprintln(out, "}")
+	return nil
+}
+
+// sizes16 returns the length in bytes for each of the eight uint16s
+// represented by the provided control byte.
+func sizes16(control uint8) [8]uint8 {
+	var sizes [8]uint8
+	for i := range sizes {
+		sizes[i] = (control >> i & 1) + 1
+	}
+	return sizes
+}
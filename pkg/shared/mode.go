@@ -1,5 +1,10 @@
 package shared
 
+import (
+	"os"
+	"strings"
+)
+
 // PerformanceMode indicates which mode the code is operating under. If Normal,
 // then the code is NOT using special hardware instructions and instead relying
 // on portable Go code. If Fast, then the code IS using special hardware instructions
@@ -13,3 +18,93 @@ const (
 )
 
 type CheckMode func() PerformanceMode
+
+// ModeEnv is the environment variable read during initialization to
+// override the process-wide mode. "scalar" forces the portable Go
+// implementation everywhere and "fast" uses the fastest implementation
+// supported by the CPU, which is also the default. Other values are
+// ignored.
+const ModeEnv = "STREAMVBYTE_MODE"
+
+var (
+	// mode is the process-wide mode. Fast lets every package use the
+	// fastest implementation supported by the CPU.
+	mode = modeFromEnv()
+)
+
+func modeFromEnv() PerformanceMode {
+	if strings.EqualFold(os.Getenv(ModeEnv), "scalar") {
+		return Normal
+	}
+	return Fast
+}
+
+func (m PerformanceMode) String() string {
+	switch m {
+	case Normal:
+		return "scalar"
+	case Fast:
+		return "fast"
+	}
+	return "unknown"
+}
+
+// GetMode returns the process-wide mode, which is initialized from the
+// ModeEnv environment variable.
+func GetMode() PerformanceMode {
+	return mode
+}
+
+// SetMode overrides the process-wide mode. Normal forces every package to
+// use the portable Go implementation and Fast restores the fastest
+// implementation supported by the CPU. SetMode must not be called
+// concurrently with any encoding or decoding func.
+func SetMode(m PerformanceMode) {
+	mode = m
+	reselect()
+}
+
+// Impl selects the implementation used by a single call of the funcs
+// taking Options.
+type Impl int
+
+const (
+	// ImplDefault uses the implementation selected for the process.
+	ImplDefault Impl = iota
+	// ImplScalar uses the portable Go implementation.
+	ImplScalar
+	// ImplFast uses the fastest implementation supported by the CPU,
+	// regardless of the process-wide mode.
+	ImplFast
+)
+
+// StreamPadding is the count of zero bytes appended to a stream written with
+// Options.Padded. The kernels load 16 bytes at a time, and the last partial
+// group of 8 integers may need up to 3 bytes more since every missing
+// integer is decoded as a single byte.
+const StreamPadding = 19
+
+// Options configures a single call of the funcs taking Options. The zero
+// value behaves like the funcs not taking Options.
+type Options struct {
+	Impl Impl
+
+	// Padded appends StreamPadding bytes to written streams and lets
+	// readers assume them, so that every group of integers, including
+	// the last partial one, is decoded with the accelerated kernels.
+	// Padded streams remain decodable by the readers not assuming them.
+	Padded bool
+}
+
+// Tier returns the tier of kernels to use for a call configured with opts,
+// given the tier currently selected for the process and the highest tier
+// supported by the CPU.
+func (opts Options) Tier(current, supported Tier) Tier {
+	switch opts.Impl {
+	case ImplScalar:
+		return TierScalar
+	case ImplFast:
+		return supported
+	}
+	return current
+}
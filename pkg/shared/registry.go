@@ -0,0 +1,288 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"golang.org/x/sys/cpu"
+)
+
+// Names of the built-in kernels, which match the tier they belong to.
+// They are reserved and cannot be used by kernels registered with Register.
+const (
+	KernelScalar = "scalar"
+	KernelSSE41  = "sse4.1"
+	KernelAVX2   = "avx2"
+)
+
+// CPU features that kernels can require.
+const (
+	FeatureSSSE3 = "ssse3"
+	FeatureSSE41 = "sse4.1"
+	FeatureAVX   = "avx"
+	FeatureAVX2  = "avx2"
+)
+
+var (
+	// ErrUnknownOp indicates that a kernel was registered for an op that
+	// is not defined by any package.
+	ErrUnknownOp = errors.New("streamvbyte: unknown op")
+
+	// ErrInvalidKernel indicates that a kernel cannot be registered for
+	// its op, e.g. because its func has the wrong signature.
+	ErrInvalidKernel = errors.New("streamvbyte: invalid kernel")
+)
+
+// Kernel is an implementation of an op, e.g. decoding 8 integers at a time,
+// that the encoding and decoding packages select from according to their
+// current tier.
+type Kernel struct {
+	// Op is the name of the op implemented by the kernel, e.g.
+	// encode.OpPut8uint32.
+	Op string
+	// Name identifies the kernel among the kernels of its op.
+	Name string
+	// Tier is the lowest tier the kernel is selected at.
+	Tier Tier
+	// Features lists the CPU features required by the kernel.
+	Features []string
+	// Func is the implementation. It must have the same signature as the
+	// scalar implementation of the op.
+	Func interface{}
+}
+
+// missing returns the features required by k that the CPU lacks.
+func (k Kernel) missing() []string {
+	var missing []string
+	for _, f := range k.Features {
+		if !HasFeature(f) {
+			missing = append(missing, f)
+		}
+	}
+	return missing
+}
+
+// Implementation reports on a registered kernel.
+type Implementation struct {
+	Kernel
+	// Available reports whether the CPU supports the kernel.
+	Available bool
+	// Selected reports whether the kernel is the one in use for its op.
+	Selected bool
+	// Reason explains why the kernel is or is not selected.
+	Reason string
+}
+
+type op struct {
+	name    string
+	kernels []Kernel
+	// tier is the tier the op was last selected for by its package.
+	tier Tier
+}
+
+var registry = struct {
+	sync.Mutex
+	ops   []*op
+	byOp  map[string]*op
+	hooks []func()
+}{
+	byOp: make(map[string]*op),
+}
+
+// HasFeature reports whether the CPU supports the feature f.
+func HasFeature(f string) bool {
+	switch f {
+	case FeatureSSSE3:
+		return cpu.X86.HasSSSE3
+	case FeatureSSE41:
+		return cpu.X86.HasSSE41
+	case FeatureAVX:
+		return cpu.X86.HasAVX
+	case FeatureAVX2:
+		return cpu.X86.HasAVX2
+	}
+	return false
+}
+
+// Define defines the op named name along with its built-in kernels, the
+// first of which must be its scalar implementation. Calling Define again
+// for the same op appends further built-in kernels. It is meant to be used
+// by the encoding and decoding packages during initialization.
+func Define(name string, builtins ...Kernel) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	o, ok := registry.byOp[name]
+	if !ok {
+		o = &op{name: name}
+		registry.ops = append(registry.ops, o)
+		registry.byOp[name] = o
+	}
+	for _, k := range builtins {
+		k.Op = name
+		o.kernels = append(o.kernels, k)
+	}
+}
+
+// Register registers the kernel k, e.g. to test an alternative
+// implementation. A kernel is selected over the built-in ones at its tier
+// and below, and over the kernels registered before it at the same tier,
+// as long as the CPU supports its features. Register must not be called
+// concurrently with any encoding or decoding func.
+func Register(k Kernel) error {
+	registry.Lock()
+	o, ok := registry.byOp[k.Op]
+	if !ok {
+		registry.Unlock()
+		return fmt.Errorf("%w: %q", ErrUnknownOp, k.Op)
+	}
+	if err := o.validate(k); err != nil {
+		registry.Unlock()
+		return err
+	}
+	o.kernels = append(o.kernels, k)
+	registry.Unlock()
+
+	reselect()
+	return nil
+}
+
+// Unregister removes the kernel named name registered with Register for
+// the op named op. Unregister must not be called concurrently with any
+// encoding or decoding func.
+func Unregister(op, name string) error {
+	registry.Lock()
+	o, ok := registry.byOp[op]
+	if !ok {
+		registry.Unlock()
+		return fmt.Errorf("%w: %q", ErrUnknownOp, op)
+	}
+	i := -1
+	switch name {
+	case KernelScalar, KernelSSE41, KernelAVX2:
+	default:
+		for j, k := range o.kernels {
+			if k.Name == name {
+				i = j
+			}
+		}
+	}
+	if i < 0 {
+		registry.Unlock()
+		return fmt.Errorf("%w: no registered kernel %q", ErrInvalidKernel, name)
+	}
+	o.kernels = append(o.kernels[:i:i], o.kernels[i+1:]...)
+	registry.Unlock()
+
+	reselect()
+	return nil
+}
+
+func (o *op) validate(k Kernel) error {
+	switch k.Name {
+	case "", KernelScalar, KernelSSE41, KernelAVX2:
+		return fmt.Errorf("%w: reserved name %q", ErrInvalidKernel, k.Name)
+	}
+	for _, other := range o.kernels {
+		if other.Name == k.Name {
+			return fmt.Errorf("%w: duplicate name %q", ErrInvalidKernel, k.Name)
+		}
+	}
+	if k.Tier < TierScalar || k.Tier > TierAVX2 {
+		return fmt.Errorf("%w: tier %v", ErrInvalidKernel, k.Tier)
+	}
+	if want := reflect.TypeOf(o.kernels[0].Func); reflect.TypeOf(k.Func) != want ||
+		reflect.ValueOf(k.Func).IsNil() {
+		return fmt.Errorf("%w: func must be a non-nil %v", ErrInvalidKernel, want)
+	}
+	return nil
+}
+
+// Select returns the kernel of the op named name to use at tier t, i.e. the
+// last registered kernel of the highest tier not above t that the CPU
+// supports.
+func Select(name string, t Tier) Kernel {
+	registry.Lock()
+	defer registry.Unlock()
+	return registry.byOp[name].selectFor(t)
+}
+
+// Use works similarly to Select except that the kernel is also reported
+// as the one in use for its op by Implementations. It is meant to be used
+// by the encoding and decoding packages whenever their tier changes.
+func Use(name string, t Tier) Kernel {
+	registry.Lock()
+	defer registry.Unlock()
+	o := registry.byOp[name]
+	o.tier = t
+	return o.selectFor(t)
+}
+
+func (o *op) selectFor(t Tier) Kernel {
+	best := o.kernels[0]
+	for _, k := range o.kernels[1:] {
+		if k.Tier <= t && k.Tier >= best.Tier && len(k.missing()) == 0 {
+			best = k
+		}
+	}
+	return best
+}
+
+// OnReselect registers fn to be called whenever SetMode or Register change
+// which kernels should be selected. It is meant to be used by the encoding
+// and decoding packages during initialization.
+func OnReselect(fn func()) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.hooks = append(registry.hooks, fn)
+}
+
+func reselect() {
+	registry.Lock()
+	hooks := registry.hooks
+	registry.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+}
+
+// Implementations reports on every kernel of every op, in the order the
+// ops were defined and the kernels registered: whether the CPU supports
+// it, whether it is in use and why.
+func Implementations() []Implementation {
+	registry.Lock()
+	defer registry.Unlock()
+
+	var (
+		impls  []Implementation
+		reason = FallbackReason()
+	)
+	for _, o := range registry.ops {
+		selected := o.selectFor(o.tier)
+		for _, k := range o.kernels {
+			impl := Implementation{
+				Kernel:    k,
+				Available: len(k.missing()) == 0,
+				Selected:  k.Name == selected.Name,
+			}
+			switch missing := k.missing(); {
+			case impl.Selected:
+				impl.Reason = fmt.Sprintf("selected for tier %v", o.tier)
+			case len(missing) != 0:
+				impl.Reason = "missing cpu features: " + strings.Join(missing, ", ")
+			case k.Tier > o.tier && reason != "":
+				impl.Reason = "disabled: " + reason
+			case k.Tier > o.tier:
+				impl.Reason = fmt.Sprintf("tier %v is above the current tier %v", k.Tier, o.tier)
+			default:
+				impl.Reason = fmt.Sprintf("superseded by %s", selected.Name)
+			}
+			impls = append(impls, impl)
+		}
+	}
+	return impls
+}
@@ -0,0 +1,128 @@
+package shared
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// SelfTestEnv is the environment variable opting into the self-test run by
+// the encoding and decoding packages during initialization, e.g.
+// STREAMVBYTE_SELFTEST=1. The self-test checks the accelerated kernels
+// against known vectors and falls back to the portable Go implementation
+// if any of them misbehaves, e.g. on hosts or emulators advertising CPU
+// features they do not properly implement.
+const SelfTestEnv = "STREAMVBYTE_SELFTEST"
+
+// ErrSelfTest indicates that an accelerated kernel produced a different
+// result than the portable Go implementation during the self-test.
+var ErrSelfTest = errors.New("streamvbyte: self-test failed")
+
+// SelfTestVector is a known vector of 8 integers along with its regular and
+// differential encodings, the latter using Prev as the base value.
+type SelfTestVector struct {
+	Nums      []uint32
+	Prev      uint32
+	Ctrl      uint16
+	Data      []byte
+	DeltaCtrl uint16
+	DeltaData []byte
+}
+
+var fallback struct {
+	sync.Mutex
+	reason string
+}
+
+// SelfTestEnabled reports whether the SelfTestEnv environment variable
+// opts into the self-test.
+func SelfTestEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(SelfTestEnv))
+	return enabled
+}
+
+// Fallback permanently forces every package to use the portable Go
+// implementation, recording reason for Implementations and FallbackReason
+// to report. Only the first reason is kept.
+func Fallback(reason string) {
+	fallback.Lock()
+	if fallback.reason == "" {
+		fallback.reason = reason
+	}
+	fallback.Unlock()
+
+	reselect()
+}
+
+// FallbackReason returns the reason recorded by Fallback, or an empty
+// string if the accelerated kernels are not disabled.
+func FallbackReason() string {
+	fallback.Lock()
+	defer fallback.Unlock()
+	return fallback.reason
+}
+
+// SelfTestVectors returns the known vectors used by the self-test. They
+// cover every byte length, both bounds of every byte length and
+// differences wrapping around at 32 bits.
+func SelfTestVectors() []SelfTestVector {
+	values := []uint32{
+		0, 1, 0x7f, 0x80, 0xff,
+		0x100, 0x7fff, 0xffff,
+		0x10000, 0x7fffff, 0xffffff,
+		0x1000000, 0x7fffffff, 0x80000000, 0xfffffffe, 0xffffffff,
+	}
+
+	var vectors []SelfTestVector
+	add := func(nums []uint32, prev uint32) {
+		v := SelfTestVector{Nums: nums, Prev: prev}
+		v.Ctrl, v.Data = selfTestEncode(nums, 0, false)
+		v.DeltaCtrl, v.DeltaData = selfTestEncode(nums, prev, true)
+		vectors = append(vectors, v)
+	}
+
+	for i := range values {
+		nums := make([]uint32, 8)
+		for j := range nums {
+			nums[j] = values[(i+j*5)%len(values)]
+		}
+		add(nums, values[(i+3)%len(values)])
+
+		same := make([]uint32, 8)
+		for j := range same {
+			same[j] = values[i]
+		}
+		add(same, values[len(values)-1-i])
+	}
+
+	return vectors
+}
+
+// selfTestEncode encodes the 8 integers from nums one at a time without
+// relying on the tables so that the self-test does not depend on them.
+func selfTestEncode(nums []uint32, prev uint32, delta bool) (uint16, []byte) {
+	var (
+		ctrl uint16
+		data = make([]byte, 0, 8*4)
+		buf  [4]byte
+	)
+
+	for i, num := range nums {
+		if delta {
+			num, prev = num-prev, num
+		}
+
+		size := 1
+		for size < 4 && num>>(8*size) != 0 {
+			size++
+		}
+
+		ctrl |= uint16(size-1) << (2 * i)
+		binary.LittleEndian.PutUint32(buf[:], num)
+		data = append(data, buf[:size]...)
+	}
+
+	return ctrl, data
+}
@@ -1133,3 +1133,2377 @@ var DecodeShuffleTable *[256][16]uint8 = &[256][16]uint8{
 	// 255	0xff	11111111	len	4	4	4	4
 	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
 }
+
+var PerNumLenTable0124 *[256][4]uint8 = &[256][4]uint8{
+	{0, 0, 0, 0}, {1, 0, 0, 0}, {2, 0, 0, 0}, {4, 0, 0, 0},
+	{0, 1, 0, 0}, {1, 1, 0, 0}, {2, 1, 0, 0}, {4, 1, 0, 0},
+	{0, 2, 0, 0}, {1, 2, 0, 0}, {2, 2, 0, 0}, {4, 2, 0, 0},
+	{0, 4, 0, 0}, {1, 4, 0, 0}, {2, 4, 0, 0}, {4, 4, 0, 0},
+	{0, 0, 1, 0}, {1, 0, 1, 0}, {2, 0, 1, 0}, {4, 0, 1, 0},
+	{0, 1, 1, 0}, {1, 1, 1, 0}, {2, 1, 1, 0}, {4, 1, 1, 0},
+	{0, 2, 1, 0}, {1, 2, 1, 0}, {2, 2, 1, 0}, {4, 2, 1, 0},
+	{0, 4, 1, 0}, {1, 4, 1, 0}, {2, 4, 1, 0}, {4, 4, 1, 0},
+	{0, 0, 2, 0}, {1, 0, 2, 0}, {2, 0, 2, 0}, {4, 0, 2, 0},
+	{0, 1, 2, 0}, {1, 1, 2, 0}, {2, 1, 2, 0}, {4, 1, 2, 0},
+	{0, 2, 2, 0}, {1, 2, 2, 0}, {2, 2, 2, 0}, {4, 2, 2, 0},
+	{0, 4, 2, 0}, {1, 4, 2, 0}, {2, 4, 2, 0}, {4, 4, 2, 0},
+	{0, 0, 4, 0}, {1, 0, 4, 0}, {2, 0, 4, 0}, {4, 0, 4, 0},
+	{0, 1, 4, 0}, {1, 1, 4, 0}, {2, 1, 4, 0}, {4, 1, 4, 0},
+	{0, 2, 4, 0}, {1, 2, 4, 0}, {2, 2, 4, 0}, {4, 2, 4, 0},
+	{0, 4, 4, 0}, {1, 4, 4, 0}, {2, 4, 4, 0}, {4, 4, 4, 0},
+	{0, 0, 0, 1}, {1, 0, 0, 1}, {2, 0, 0, 1}, {4, 0, 0, 1},
+	{0, 1, 0, 1}, {1, 1, 0, 1}, {2, 1, 0, 1}, {4, 1, 0, 1},
+	{0, 2, 0, 1}, {1, 2, 0, 1}, {2, 2, 0, 1}, {4, 2, 0, 1},
+	{0, 4, 0, 1}, {1, 4, 0, 1}, {2, 4, 0, 1}, {4, 4, 0, 1},
+	{0, 0, 1, 1}, {1, 0, 1, 1}, {2, 0, 1, 1}, {4, 0, 1, 1},
+	{0, 1, 1, 1}, {1, 1, 1, 1}, {2, 1, 1, 1}, {4, 1, 1, 1},
+	{0, 2, 1, 1}, {1, 2, 1, 1}, {2, 2, 1, 1}, {4, 2, 1, 1},
+	{0, 4, 1, 1}, {1, 4, 1, 1}, {2, 4, 1, 1}, {4, 4, 1, 1},
+	{0, 0, 2, 1}, {1, 0, 2, 1}, {2, 0, 2, 1}, {4, 0, 2, 1},
+	{0, 1, 2, 1}, {1, 1, 2, 1}, {2, 1, 2, 1}, {4, 1, 2, 1},
+	{0, 2, 2, 1}, {1, 2, 2, 1}, {2, 2, 2, 1}, {4, 2, 2, 1},
+	{0, 4, 2, 1}, {1, 4, 2, 1}, {2, 4, 2, 1}, {4, 4, 2, 1},
+	{0, 0, 4, 1}, {1, 0, 4, 1}, {2, 0, 4, 1}, {4, 0, 4, 1},
+	{0, 1, 4, 1}, {1, 1, 4, 1}, {2, 1, 4, 1}, {4, 1, 4, 1},
+	{0, 2, 4, 1}, {1, 2, 4, 1}, {2, 2, 4, 1}, {4, 2, 4, 1},
+	{0, 4, 4, 1}, {1, 4, 4, 1}, {2, 4, 4, 1}, {4, 4, 4, 1},
+	{0, 0, 0, 2}, {1, 0, 0, 2}, {2, 0, 0, 2}, {4, 0, 0, 2},
+	{0, 1, 0, 2}, {1, 1, 0, 2}, {2, 1, 0, 2}, {4, 1, 0, 2},
+	{0, 2, 0, 2}, {1, 2, 0, 2}, {2, 2, 0, 2}, {4, 2, 0, 2},
+	{0, 4, 0, 2}, {1, 4, 0, 2}, {2, 4, 0, 2}, {4, 4, 0, 2},
+	{0, 0, 1, 2}, {1, 0, 1, 2}, {2, 0, 1, 2}, {4, 0, 1, 2},
+	{0, 1, 1, 2}, {1, 1, 1, 2}, {2, 1, 1, 2}, {4, 1, 1, 2},
+	{0, 2, 1, 2}, {1, 2, 1, 2}, {2, 2, 1, 2}, {4, 2, 1, 2},
+	{0, 4, 1, 2}, {1, 4, 1, 2}, {2, 4, 1, 2}, {4, 4, 1, 2},
+	{0, 0, 2, 2}, {1, 0, 2, 2}, {2, 0, 2, 2}, {4, 0, 2, 2},
+	{0, 1, 2, 2}, {1, 1, 2, 2}, {2, 1, 2, 2}, {4, 1, 2, 2},
+	{0, 2, 2, 2}, {1, 2, 2, 2}, {2, 2, 2, 2}, {4, 2, 2, 2},
+	{0, 4, 2, 2}, {1, 4, 2, 2}, {2, 4, 2, 2}, {4, 4, 2, 2},
+	{0, 0, 4, 2}, {1, 0, 4, 2}, {2, 0, 4, 2}, {4, 0, 4, 2},
+	{0, 1, 4, 2}, {1, 1, 4, 2}, {2, 1, 4, 2}, {4, 1, 4, 2},
+	{0, 2, 4, 2}, {1, 2, 4, 2}, {2, 2, 4, 2}, {4, 2, 4, 2},
+	{0, 4, 4, 2}, {1, 4, 4, 2}, {2, 4, 4, 2}, {4, 4, 4, 2},
+	{0, 0, 0, 4}, {1, 0, 0, 4}, {2, 0, 0, 4}, {4, 0, 0, 4},
+	{0, 1, 0, 4}, {1, 1, 0, 4}, {2, 1, 0, 4}, {4, 1, 0, 4},
+	{0, 2, 0, 4}, {1, 2, 0, 4}, {2, 2, 0, 4}, {4, 2, 0, 4},
+	{0, 4, 0, 4}, {1, 4, 0, 4}, {2, 4, 0, 4}, {4, 4, 0, 4},
+	{0, 0, 1, 4}, {1, 0, 1, 4}, {2, 0, 1, 4}, {4, 0, 1, 4},
+	{0, 1, 1, 4}, {1, 1, 1, 4}, {2, 1, 1, 4}, {4, 1, 1, 4},
+	{0, 2, 1, 4}, {1, 2, 1, 4}, {2, 2, 1, 4}, {4, 2, 1, 4},
+	{0, 4, 1, 4}, {1, 4, 1, 4}, {2, 4, 1, 4}, {4, 4, 1, 4},
+	{0, 0, 2, 4}, {1, 0, 2, 4}, {2, 0, 2, 4}, {4, 0, 2, 4},
+	{0, 1, 2, 4}, {1, 1, 2, 4}, {2, 1, 2, 4}, {4, 1, 2, 4},
+	{0, 2, 2, 4}, {1, 2, 2, 4}, {2, 2, 2, 4}, {4, 2, 2, 4},
+	{0, 4, 2, 4}, {1, 4, 2, 4}, {2, 4, 2, 4}, {4, 4, 2, 4},
+	{0, 0, 4, 4}, {1, 0, 4, 4}, {2, 0, 4, 4}, {4, 0, 4, 4},
+	{0, 1, 4, 4}, {1, 1, 4, 4}, {2, 1, 4, 4}, {4, 1, 4, 4},
+	{0, 2, 4, 4}, {1, 2, 4, 4}, {2, 2, 4, 4}, {4, 2, 4, 4},
+	{0, 4, 4, 4}, {1, 4, 4, 4}, {2, 4, 4, 4}, {4, 4, 4, 4},
+}
+
+var PerControlLenTable0124 *[256]uint8 = &[256]uint8{
+	0, 1, 2, 4, 1, 2, 3, 5,
+	2, 3, 4, 6, 4, 5, 6, 8,
+	1, 2, 3, 5, 2, 3, 4, 6,
+	3, 4, 5, 7, 5, 6, 7, 9,
+	2, 3, 4, 6, 3, 4, 5, 7,
+	4, 5, 6, 8, 6, 7, 8, 10,
+	4, 5, 6, 8, 5, 6, 7, 9,
+	6, 7, 8, 10, 8, 9, 10, 12,
+	1, 2, 3, 5, 2, 3, 4, 6,
+	3, 4, 5, 7, 5, 6, 7, 9,
+	2, 3, 4, 6, 3, 4, 5, 7,
+	4, 5, 6, 8, 6, 7, 8, 10,
+	3, 4, 5, 7, 4, 5, 6, 8,
+	5, 6, 7, 9, 7, 8, 9, 11,
+	5, 6, 7, 9, 6, 7, 8, 10,
+	7, 8, 9, 11, 9, 10, 11, 13,
+	2, 3, 4, 6, 3, 4, 5, 7,
+	4, 5, 6, 8, 6, 7, 8, 10,
+	3, 4, 5, 7, 4, 5, 6, 8,
+	5, 6, 7, 9, 7, 8, 9, 11,
+	4, 5, 6, 8, 5, 6, 7, 9,
+	6, 7, 8, 10, 8, 9, 10, 12,
+	6, 7, 8, 10, 7, 8, 9, 11,
+	8, 9, 10, 12, 10, 11, 12, 14,
+	4, 5, 6, 8, 5, 6, 7, 9,
+	6, 7, 8, 10, 8, 9, 10, 12,
+	5, 6, 7, 9, 6, 7, 8, 10,
+	7, 8, 9, 11, 9, 10, 11, 13,
+	6, 7, 8, 10, 7, 8, 9, 11,
+	8, 9, 10, 12, 10, 11, 12, 14,
+	8, 9, 10, 12, 9, 10, 11, 13,
+	10, 11, 12, 14, 12, 13, 14, 16,
+}
+
+var EncodeShuffleTable0124 *[256][16]uint8 = &[256][16]uint8{
+	// 0	0x00	00000000	len	0	0	0	0
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 1	0x01	00000001	len	1	0	0	0
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 2	0x02	00000010	len	2	0	0	0
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 3	0x03	00000011	len	4	0	0	0
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 4	0x04	00000100	len	0	1	0	0
+	{0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 5	0x05	00000101	len	1	1	0	0
+	{0x00, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 6	0x06	00000110	len	2	1	0	0
+	{0x00, 0x01, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 7	0x07	00000111	len	4	1	0	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 8	0x08	00001000	len	0	2	0	0
+	{0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 9	0x09	00001001	len	1	2	0	0
+	{0x00, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 10	0x0a	00001010	len	2	2	0	0
+	{0x00, 0x01, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 11	0x0b	00001011	len	4	2	0	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 12	0x0c	00001100	len	0	4	0	0
+	{0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 13	0x0d	00001101	len	1	4	0	0
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 14	0x0e	00001110	len	2	4	0	0
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 15	0x0f	00001111	len	4	4	0	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 16	0x10	00010000	len	0	0	1	0
+	{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 17	0x11	00010001	len	1	0	1	0
+	{0x00, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 18	0x12	00010010	len	2	0	1	0
+	{0x00, 0x01, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 19	0x13	00010011	len	4	0	1	0
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 20	0x14	00010100	len	0	1	1	0
+	{0x04, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 21	0x15	00010101	len	1	1	1	0
+	{0x00, 0x04, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 22	0x16	00010110	len	2	1	1	0
+	{0x00, 0x01, 0x04, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 23	0x17	00010111	len	4	1	1	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 24	0x18	00011000	len	0	2	1	0
+	{0x04, 0x05, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 25	0x19	00011001	len	1	2	1	0
+	{0x00, 0x04, 0x05, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 26	0x1a	00011010	len	2	2	1	0
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 27	0x1b	00011011	len	4	2	1	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 28	0x1c	00011100	len	0	4	1	0
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 29	0x1d	00011101	len	1	4	1	0
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 30	0x1e	00011110	len	2	4	1	0
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 31	0x1f	00011111	len	4	4	1	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 32	0x20	00100000	len	0	0	2	0
+	{0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 33	0x21	00100001	len	1	0	2	0
+	{0x00, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 34	0x22	00100010	len	2	0	2	0
+	{0x00, 0x01, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 35	0x23	00100011	len	4	0	2	0
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 36	0x24	00100100	len	0	1	2	0
+	{0x04, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 37	0x25	00100101	len	1	1	2	0
+	{0x00, 0x04, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 38	0x26	00100110	len	2	1	2	0
+	{0x00, 0x01, 0x04, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 39	0x27	00100111	len	4	1	2	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 40	0x28	00101000	len	0	2	2	0
+	{0x04, 0x05, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 41	0x29	00101001	len	1	2	2	0
+	{0x00, 0x04, 0x05, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 42	0x2a	00101010	len	2	2	2	0
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 43	0x2b	00101011	len	4	2	2	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 44	0x2c	00101100	len	0	4	2	0
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 45	0x2d	00101101	len	1	4	2	0
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 46	0x2e	00101110	len	2	4	2	0
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 47	0x2f	00101111	len	4	4	2	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 48	0x30	00110000	len	0	0	4	0
+	{0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 49	0x31	00110001	len	1	0	4	0
+	{0x00, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 50	0x32	00110010	len	2	0	4	0
+	{0x00, 0x01, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 51	0x33	00110011	len	4	0	4	0
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 52	0x34	00110100	len	0	1	4	0
+	{0x04, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 53	0x35	00110101	len	1	1	4	0
+	{0x00, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 54	0x36	00110110	len	2	1	4	0
+	{0x00, 0x01, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 55	0x37	00110111	len	4	1	4	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 56	0x38	00111000	len	0	2	4	0
+	{0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 57	0x39	00111001	len	1	2	4	0
+	{0x00, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 58	0x3a	00111010	len	2	2	4	0
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 59	0x3b	00111011	len	4	2	4	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 60	0x3c	00111100	len	0	4	4	0
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 61	0x3d	00111101	len	1	4	4	0
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 62	0x3e	00111110	len	2	4	4	0
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 63	0x3f	00111111	len	4	4	4	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff},
+	// 64	0x40	01000000	len	0	0	0	1
+	{0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 65	0x41	01000001	len	1	0	0	1
+	{0x00, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 66	0x42	01000010	len	2	0	0	1
+	{0x00, 0x01, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 67	0x43	01000011	len	4	0	0	1
+	{0x00, 0x01, 0x02, 0x03, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 68	0x44	01000100	len	0	1	0	1
+	{0x04, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 69	0x45	01000101	len	1	1	0	1
+	{0x00, 0x04, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 70	0x46	01000110	len	2	1	0	1
+	{0x00, 0x01, 0x04, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 71	0x47	01000111	len	4	1	0	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 72	0x48	01001000	len	0	2	0	1
+	{0x04, 0x05, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 73	0x49	01001001	len	1	2	0	1
+	{0x00, 0x04, 0x05, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 74	0x4a	01001010	len	2	2	0	1
+	{0x00, 0x01, 0x04, 0x05, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 75	0x4b	01001011	len	4	2	0	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 76	0x4c	01001100	len	0	4	0	1
+	{0x04, 0x05, 0x06, 0x07, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 77	0x4d	01001101	len	1	4	0	1
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 78	0x4e	01001110	len	2	4	0	1
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 79	0x4f	01001111	len	4	4	0	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 80	0x50	01010000	len	0	0	1	1
+	{0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 81	0x51	01010001	len	1	0	1	1
+	{0x00, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 82	0x52	01010010	len	2	0	1	1
+	{0x00, 0x01, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 83	0x53	01010011	len	4	0	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 84	0x54	01010100	len	0	1	1	1
+	{0x04, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 85	0x55	01010101	len	1	1	1	1
+	{0x00, 0x04, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 86	0x56	01010110	len	2	1	1	1
+	{0x00, 0x01, 0x04, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 87	0x57	01010111	len	4	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 88	0x58	01011000	len	0	2	1	1
+	{0x04, 0x05, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 89	0x59	01011001	len	1	2	1	1
+	{0x00, 0x04, 0x05, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 90	0x5a	01011010	len	2	2	1	1
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 91	0x5b	01011011	len	4	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 92	0x5c	01011100	len	0	4	1	1
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 93	0x5d	01011101	len	1	4	1	1
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 94	0x5e	01011110	len	2	4	1	1
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 95	0x5f	01011111	len	4	4	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 96	0x60	01100000	len	0	0	2	1
+	{0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 97	0x61	01100001	len	1	0	2	1
+	{0x00, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 98	0x62	01100010	len	2	0	2	1
+	{0x00, 0x01, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 99	0x63	01100011	len	4	0	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 100	0x64	01100100	len	0	1	2	1
+	{0x04, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 101	0x65	01100101	len	1	1	2	1
+	{0x00, 0x04, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 102	0x66	01100110	len	2	1	2	1
+	{0x00, 0x01, 0x04, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 103	0x67	01100111	len	4	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 104	0x68	01101000	len	0	2	2	1
+	{0x04, 0x05, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 105	0x69	01101001	len	1	2	2	1
+	{0x00, 0x04, 0x05, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 106	0x6a	01101010	len	2	2	2	1
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 107	0x6b	01101011	len	4	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 108	0x6c	01101100	len	0	4	2	1
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 109	0x6d	01101101	len	1	4	2	1
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 110	0x6e	01101110	len	2	4	2	1
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 111	0x6f	01101111	len	4	4	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 112	0x70	01110000	len	0	0	4	1
+	{0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 113	0x71	01110001	len	1	0	4	1
+	{0x00, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 114	0x72	01110010	len	2	0	4	1
+	{0x00, 0x01, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 115	0x73	01110011	len	4	0	4	1
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 116	0x74	01110100	len	0	1	4	1
+	{0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 117	0x75	01110101	len	1	1	4	1
+	{0x00, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 118	0x76	01110110	len	2	1	4	1
+	{0x00, 0x01, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 119	0x77	01110111	len	4	1	4	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 120	0x78	01111000	len	0	2	4	1
+	{0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 121	0x79	01111001	len	1	2	4	1
+	{0x00, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 122	0x7a	01111010	len	2	2	4	1
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 123	0x7b	01111011	len	4	2	4	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 124	0x7c	01111100	len	0	4	4	1
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 125	0x7d	01111101	len	1	4	4	1
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 126	0x7e	01111110	len	2	4	4	1
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 127	0x7f	01111111	len	4	4	4	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff},
+	// 128	0x80	10000000	len	0	0	0	2
+	{0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 129	0x81	10000001	len	1	0	0	2
+	{0x00, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 130	0x82	10000010	len	2	0	0	2
+	{0x00, 0x01, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 131	0x83	10000011	len	4	0	0	2
+	{0x00, 0x01, 0x02, 0x03, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 132	0x84	10000100	len	0	1	0	2
+	{0x04, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 133	0x85	10000101	len	1	1	0	2
+	{0x00, 0x04, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 134	0x86	10000110	len	2	1	0	2
+	{0x00, 0x01, 0x04, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 135	0x87	10000111	len	4	1	0	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 136	0x88	10001000	len	0	2	0	2
+	{0x04, 0x05, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 137	0x89	10001001	len	1	2	0	2
+	{0x00, 0x04, 0x05, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 138	0x8a	10001010	len	2	2	0	2
+	{0x00, 0x01, 0x04, 0x05, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 139	0x8b	10001011	len	4	2	0	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 140	0x8c	10001100	len	0	4	0	2
+	{0x04, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 141	0x8d	10001101	len	1	4	0	2
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 142	0x8e	10001110	len	2	4	0	2
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 143	0x8f	10001111	len	4	4	0	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 144	0x90	10010000	len	0	0	1	2
+	{0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 145	0x91	10010001	len	1	0	1	2
+	{0x00, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 146	0x92	10010010	len	2	0	1	2
+	{0x00, 0x01, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 147	0x93	10010011	len	4	0	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 148	0x94	10010100	len	0	1	1	2
+	{0x04, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 149	0x95	10010101	len	1	1	1	2
+	{0x00, 0x04, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 150	0x96	10010110	len	2	1	1	2
+	{0x00, 0x01, 0x04, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 151	0x97	10010111	len	4	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 152	0x98	10011000	len	0	2	1	2
+	{0x04, 0x05, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 153	0x99	10011001	len	1	2	1	2
+	{0x00, 0x04, 0x05, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 154	0x9a	10011010	len	2	2	1	2
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 155	0x9b	10011011	len	4	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 156	0x9c	10011100	len	0	4	1	2
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 157	0x9d	10011101	len	1	4	1	2
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 158	0x9e	10011110	len	2	4	1	2
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 159	0x9f	10011111	len	4	4	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 160	0xa0	10100000	len	0	0	2	2
+	{0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 161	0xa1	10100001	len	1	0	2	2
+	{0x00, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 162	0xa2	10100010	len	2	0	2	2
+	{0x00, 0x01, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 163	0xa3	10100011	len	4	0	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 164	0xa4	10100100	len	0	1	2	2
+	{0x04, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 165	0xa5	10100101	len	1	1	2	2
+	{0x00, 0x04, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 166	0xa6	10100110	len	2	1	2	2
+	{0x00, 0x01, 0x04, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 167	0xa7	10100111	len	4	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 168	0xa8	10101000	len	0	2	2	2
+	{0x04, 0x05, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 169	0xa9	10101001	len	1	2	2	2
+	{0x00, 0x04, 0x05, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 170	0xaa	10101010	len	2	2	2	2
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 171	0xab	10101011	len	4	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 172	0xac	10101100	len	0	4	2	2
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 173	0xad	10101101	len	1	4	2	2
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 174	0xae	10101110	len	2	4	2	2
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 175	0xaf	10101111	len	4	4	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff},
+	// 176	0xb0	10110000	len	0	0	4	2
+	{0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 177	0xb1	10110001	len	1	0	4	2
+	{0x00, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 178	0xb2	10110010	len	2	0	4	2
+	{0x00, 0x01, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 179	0xb3	10110011	len	4	0	4	2
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 180	0xb4	10110100	len	0	1	4	2
+	{0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 181	0xb5	10110101	len	1	1	4	2
+	{0x00, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 182	0xb6	10110110	len	2	1	4	2
+	{0x00, 0x01, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 183	0xb7	10110111	len	4	1	4	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 184	0xb8	10111000	len	0	2	4	2
+	{0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 185	0xb9	10111001	len	1	2	4	2
+	{0x00, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 186	0xba	10111010	len	2	2	4	2
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 187	0xbb	10111011	len	4	2	4	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff},
+	// 188	0xbc	10111100	len	0	4	4	2
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 189	0xbd	10111101	len	1	4	4	2
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 190	0xbe	10111110	len	2	4	4	2
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff, 0xff, 0xff},
+	// 191	0xbf	10111111	len	4	4	4	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff},
+	// 192	0xc0	11000000	len	0	0	0	4
+	{0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 193	0xc1	11000001	len	1	0	0	4
+	{0x00, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 194	0xc2	11000010	len	2	0	0	4
+	{0x00, 0x01, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 195	0xc3	11000011	len	4	0	0	4
+	{0x00, 0x01, 0x02, 0x03, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 196	0xc4	11000100	len	0	1	0	4
+	{0x04, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 197	0xc5	11000101	len	1	1	0	4
+	{0x00, 0x04, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 198	0xc6	11000110	len	2	1	0	4
+	{0x00, 0x01, 0x04, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 199	0xc7	11000111	len	4	1	0	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 200	0xc8	11001000	len	0	2	0	4
+	{0x04, 0x05, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 201	0xc9	11001001	len	1	2	0	4
+	{0x00, 0x04, 0x05, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 202	0xca	11001010	len	2	2	0	4
+	{0x00, 0x01, 0x04, 0x05, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 203	0xcb	11001011	len	4	2	0	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 204	0xcc	11001100	len	0	4	0	4
+	{0x04, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 205	0xcd	11001101	len	1	4	0	4
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 206	0xce	11001110	len	2	4	0	4
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 207	0xcf	11001111	len	4	4	0	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 208	0xd0	11010000	len	0	0	1	4
+	{0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 209	0xd1	11010001	len	1	0	1	4
+	{0x00, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 210	0xd2	11010010	len	2	0	1	4
+	{0x00, 0x01, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 211	0xd3	11010011	len	4	0	1	4
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 212	0xd4	11010100	len	0	1	1	4
+	{0x04, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 213	0xd5	11010101	len	1	1	1	4
+	{0x00, 0x04, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 214	0xd6	11010110	len	2	1	1	4
+	{0x00, 0x01, 0x04, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 215	0xd7	11010111	len	4	1	1	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 216	0xd8	11011000	len	0	2	1	4
+	{0x04, 0x05, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 217	0xd9	11011001	len	1	2	1	4
+	{0x00, 0x04, 0x05, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 218	0xda	11011010	len	2	2	1	4
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 219	0xdb	11011011	len	4	2	1	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 220	0xdc	11011100	len	0	4	1	4
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 221	0xdd	11011101	len	1	4	1	4
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 222	0xde	11011110	len	2	4	1	4
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 223	0xdf	11011111	len	4	4	1	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 224	0xe0	11100000	len	0	0	2	4
+	{0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 225	0xe1	11100001	len	1	0	2	4
+	{0x00, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 226	0xe2	11100010	len	2	0	2	4
+	{0x00, 0x01, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 227	0xe3	11100011	len	4	0	2	4
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 228	0xe4	11100100	len	0	1	2	4
+	{0x04, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 229	0xe5	11100101	len	1	1	2	4
+	{0x00, 0x04, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 230	0xe6	11100110	len	2	1	2	4
+	{0x00, 0x01, 0x04, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 231	0xe7	11100111	len	4	1	2	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 232	0xe8	11101000	len	0	2	2	4
+	{0x04, 0x05, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 233	0xe9	11101001	len	1	2	2	4
+	{0x00, 0x04, 0x05, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 234	0xea	11101010	len	2	2	2	4
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 235	0xeb	11101011	len	4	2	2	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 236	0xec	11101100	len	0	4	2	4
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 237	0xed	11101101	len	1	4	2	4
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 238	0xee	11101110	len	2	4	2	4
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 239	0xef	11101111	len	4	4	2	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 240	0xf0	11110000	len	0	0	4	4
+	{0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 241	0xf1	11110001	len	1	0	4	4
+	{0x00, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 242	0xf2	11110010	len	2	0	4	4
+	{0x00, 0x01, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 243	0xf3	11110011	len	4	0	4	4
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 244	0xf4	11110100	len	0	1	4	4
+	{0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 245	0xf5	11110101	len	1	1	4	4
+	{0x00, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 246	0xf6	11110110	len	2	1	4	4
+	{0x00, 0x01, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 247	0xf7	11110111	len	4	1	4	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 248	0xf8	11111000	len	0	2	4	4
+	{0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 249	0xf9	11111001	len	1	2	4	4
+	{0x00, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 250	0xfa	11111010	len	2	2	4	4
+	{0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 251	0xfb	11111011	len	4	2	4	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 252	0xfc	11111100	len	0	4	4	4
+	{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 253	0xfd	11111101	len	1	4	4	4
+	{0x00, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 254	0xfe	11111110	len	2	4	4	4
+	{0x00, 0x01, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 255	0xff	11111111	len	4	4	4	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+}
+
+var DecodeShuffleTable0124 *[256][16]uint8 = &[256][16]uint8{
+	// 0	0x00	00000000	len	0	0	0	0
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 1	0x01	00000001	len	1	0	0	0
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 2	0x02	00000010	len	2	0	0	0
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 3	0x03	00000011	len	4	0	0	0
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 4	0x04	00000100	len	0	1	0	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 5	0x05	00000101	len	1	1	0	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 6	0x06	00000110	len	2	1	0	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 7	0x07	00000111	len	4	1	0	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 8	0x08	00001000	len	0	2	0	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 9	0x09	00001001	len	1	2	0	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 10	0x0a	00001010	len	2	2	0	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 11	0x0b	00001011	len	4	2	0	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 12	0x0c	00001100	len	0	4	0	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 13	0x0d	00001101	len	1	4	0	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 14	0x0e	00001110	len	2	4	0	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 15	0x0f	00001111	len	4	4	0	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 16	0x10	00010000	len	0	0	1	0
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 17	0x11	00010001	len	1	0	1	0
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 18	0x12	00010010	len	2	0	1	0
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 19	0x13	00010011	len	4	0	1	0
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 20	0x14	00010100	len	0	1	1	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 21	0x15	00010101	len	1	1	1	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 22	0x16	00010110	len	2	1	1	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 23	0x17	00010111	len	4	1	1	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 24	0x18	00011000	len	0	2	1	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 25	0x19	00011001	len	1	2	1	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 26	0x1a	00011010	len	2	2	1	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 27	0x1b	00011011	len	4	2	1	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 28	0x1c	00011100	len	0	4	1	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 29	0x1d	00011101	len	1	4	1	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 30	0x1e	00011110	len	2	4	1	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 31	0x1f	00011111	len	4	4	1	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 32	0x20	00100000	len	0	0	2	0
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 33	0x21	00100001	len	1	0	2	0
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 34	0x22	00100010	len	2	0	2	0
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 35	0x23	00100011	len	4	0	2	0
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 36	0x24	00100100	len	0	1	2	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 37	0x25	00100101	len	1	1	2	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 38	0x26	00100110	len	2	1	2	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 39	0x27	00100111	len	4	1	2	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 40	0x28	00101000	len	0	2	2	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 41	0x29	00101001	len	1	2	2	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 42	0x2a	00101010	len	2	2	2	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 43	0x2b	00101011	len	4	2	2	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 44	0x2c	00101100	len	0	4	2	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 45	0x2d	00101101	len	1	4	2	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 46	0x2e	00101110	len	2	4	2	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 47	0x2f	00101111	len	4	4	2	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 48	0x30	00110000	len	0	0	4	0
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff},
+	// 49	0x31	00110001	len	1	0	4	0
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff},
+	// 50	0x32	00110010	len	2	0	4	0
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff},
+	// 51	0x33	00110011	len	4	0	4	0
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff},
+	// 52	0x34	00110100	len	0	1	4	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff},
+	// 53	0x35	00110101	len	1	1	4	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff},
+	// 54	0x36	00110110	len	2	1	4	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff, 0xff},
+	// 55	0x37	00110111	len	4	1	4	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff},
+	// 56	0x38	00111000	len	0	2	4	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff},
+	// 57	0x39	00111001	len	1	2	4	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff, 0xff},
+	// 58	0x3a	00111010	len	2	2	4	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff},
+	// 59	0x3b	00111011	len	4	2	4	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff},
+	// 60	0x3c	00111100	len	0	4	4	0
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff},
+	// 61	0x3d	00111101	len	1	4	4	0
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff},
+	// 62	0x3e	00111110	len	2	4	4	0
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff},
+	// 63	0x3f	00111111	len	4	4	4	0
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff},
+	// 64	0x40	01000000	len	0	0	0	1
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff},
+	// 65	0x41	01000001	len	1	0	0	1
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff},
+	// 66	0x42	01000010	len	2	0	0	1
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff},
+	// 67	0x43	01000011	len	4	0	0	1
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff},
+	// 68	0x44	01000100	len	0	1	0	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff},
+	// 69	0x45	01000101	len	1	1	0	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff},
+	// 70	0x46	01000110	len	2	1	0	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff},
+	// 71	0x47	01000111	len	4	1	0	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff},
+	// 72	0x48	01001000	len	0	2	0	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff},
+	// 73	0x49	01001001	len	1	2	0	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff},
+	// 74	0x4a	01001010	len	2	2	0	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff},
+	// 75	0x4b	01001011	len	4	2	0	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff},
+	// 76	0x4c	01001100	len	0	4	0	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff},
+	// 77	0x4d	01001101	len	1	4	0	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff},
+	// 78	0x4e	01001110	len	2	4	0	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff},
+	// 79	0x4f	01001111	len	4	4	0	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0x08, 0xff, 0xff, 0xff},
+	// 80	0x50	01010000	len	0	0	1	1
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff},
+	// 81	0x51	01010001	len	1	0	1	1
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff},
+	// 82	0x52	01010010	len	2	0	1	1
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff},
+	// 83	0x53	01010011	len	4	0	1	1
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff},
+	// 84	0x54	01010100	len	0	1	1	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff},
+	// 85	0x55	01010101	len	1	1	1	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff},
+	// 86	0x56	01010110	len	2	1	1	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff},
+	// 87	0x57	01010111	len	4	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff},
+	// 88	0x58	01011000	len	0	2	1	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff},
+	// 89	0x59	01011001	len	1	2	1	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff},
+	// 90	0x5a	01011010	len	2	2	1	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff},
+	// 91	0x5b	01011011	len	4	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff, 0x07, 0xff, 0xff, 0xff},
+	// 92	0x5c	01011100	len	0	4	1	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff},
+	// 93	0x5d	01011101	len	1	4	1	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff},
+	// 94	0x5e	01011110	len	2	4	1	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff, 0x07, 0xff, 0xff, 0xff},
+	// 95	0x5f	01011111	len	4	4	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0x09, 0xff, 0xff, 0xff},
+	// 96	0x60	01100000	len	0	0	2	1
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff},
+	// 97	0x61	01100001	len	1	0	2	1
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff},
+	// 98	0x62	01100010	len	2	0	2	1
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff},
+	// 99	0x63	01100011	len	4	0	2	1
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff},
+	// 100	0x64	01100100	len	0	1	2	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff},
+	// 101	0x65	01100101	len	1	1	2	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff},
+	// 102	0x66	01100110	len	2	1	2	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff},
+	// 103	0x67	01100111	len	4	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff, 0x07, 0xff, 0xff, 0xff},
+	// 104	0x68	01101000	len	0	2	2	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff},
+	// 105	0x69	01101001	len	1	2	2	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff},
+	// 106	0x6a	01101010	len	2	2	2	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff},
+	// 107	0x6b	01101011	len	4	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff, 0x08, 0xff, 0xff, 0xff},
+	// 108	0x6c	01101100	len	0	4	2	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff},
+	// 109	0x6d	01101101	len	1	4	2	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0x07, 0xff, 0xff, 0xff},
+	// 110	0x6e	01101110	len	2	4	2	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0x08, 0xff, 0xff, 0xff},
+	// 111	0x6f	01101111	len	4	4	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0x0a, 0xff, 0xff, 0xff},
+	// 112	0x70	01110000	len	0	0	4	1
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff},
+	// 113	0x71	01110001	len	1	0	4	1
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff},
+	// 114	0x72	01110010	len	2	0	4	1
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff},
+	// 115	0x73	01110011	len	4	0	4	1
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff},
+	// 116	0x74	01110100	len	0	1	4	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff},
+	// 117	0x75	01110101	len	1	1	4	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff},
+	// 118	0x76	01110110	len	2	1	4	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff},
+	// 119	0x77	01110111	len	4	1	4	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff},
+	// 120	0x78	01111000	len	0	2	4	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff},
+	// 121	0x79	01111001	len	1	2	4	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff},
+	// 122	0x7a	01111010	len	2	2	4	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff},
+	// 123	0x7b	01111011	len	4	2	4	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0xff, 0xff},
+	// 124	0x7c	01111100	len	0	4	4	1
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff},
+	// 125	0x7d	01111101	len	1	4	4	1
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff},
+	// 126	0x7e	01111110	len	2	4	4	1
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0xff, 0xff},
+	// 127	0x7f	01111111	len	4	4	4	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0xff, 0xff},
+	// 128	0x80	10000000	len	0	0	0	2
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff},
+	// 129	0x81	10000001	len	1	0	0	2
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff},
+	// 130	0x82	10000010	len	2	0	0	2
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff},
+	// 131	0x83	10000011	len	4	0	0	2
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff},
+	// 132	0x84	10000100	len	0	1	0	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff},
+	// 133	0x85	10000101	len	1	1	0	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff},
+	// 134	0x86	10000110	len	2	1	0	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff},
+	// 135	0x87	10000111	len	4	1	0	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff},
+	// 136	0x88	10001000	len	0	2	0	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff},
+	// 137	0x89	10001001	len	1	2	0	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff},
+	// 138	0x8a	10001010	len	2	2	0	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff},
+	// 139	0x8b	10001011	len	4	2	0	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff},
+	// 140	0x8c	10001100	len	0	4	0	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff},
+	// 141	0x8d	10001101	len	1	4	0	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff},
+	// 142	0x8e	10001110	len	2	4	0	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff},
+	// 143	0x8f	10001111	len	4	4	0	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0x08, 0x09, 0xff, 0xff},
+	// 144	0x90	10010000	len	0	0	1	2
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff},
+	// 145	0x91	10010001	len	1	0	1	2
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff},
+	// 146	0x92	10010010	len	2	0	1	2
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff},
+	// 147	0x93	10010011	len	4	0	1	2
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff},
+	// 148	0x94	10010100	len	0	1	1	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff},
+	// 149	0x95	10010101	len	1	1	1	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff},
+	// 150	0x96	10010110	len	2	1	1	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff},
+	// 151	0x97	10010111	len	4	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff},
+	// 152	0x98	10011000	len	0	2	1	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff},
+	// 153	0x99	10011001	len	1	2	1	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff},
+	// 154	0x9a	10011010	len	2	2	1	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff},
+	// 155	0x9b	10011011	len	4	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff, 0x07, 0x08, 0xff, 0xff},
+	// 156	0x9c	10011100	len	0	4	1	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff},
+	// 157	0x9d	10011101	len	1	4	1	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff},
+	// 158	0x9e	10011110	len	2	4	1	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff, 0x07, 0x08, 0xff, 0xff},
+	// 159	0x9f	10011111	len	4	4	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0x09, 0x0a, 0xff, 0xff},
+	// 160	0xa0	10100000	len	0	0	2	2
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff},
+	// 161	0xa1	10100001	len	1	0	2	2
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff},
+	// 162	0xa2	10100010	len	2	0	2	2
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff},
+	// 163	0xa3	10100011	len	4	0	2	2
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff},
+	// 164	0xa4	10100100	len	0	1	2	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff},
+	// 165	0xa5	10100101	len	1	1	2	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff},
+	// 166	0xa6	10100110	len	2	1	2	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff},
+	// 167	0xa7	10100111	len	4	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff, 0x07, 0x08, 0xff, 0xff},
+	// 168	0xa8	10101000	len	0	2	2	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff},
+	// 169	0xa9	10101001	len	1	2	2	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff},
+	// 170	0xaa	10101010	len	2	2	2	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff},
+	// 171	0xab	10101011	len	4	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff, 0x08, 0x09, 0xff, 0xff},
+	// 172	0xac	10101100	len	0	4	2	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff},
+	// 173	0xad	10101101	len	1	4	2	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0x07, 0x08, 0xff, 0xff},
+	// 174	0xae	10101110	len	2	4	2	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0x08, 0x09, 0xff, 0xff},
+	// 175	0xaf	10101111	len	4	4	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0x0a, 0x0b, 0xff, 0xff},
+	// 176	0xb0	10110000	len	0	0	4	2
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff},
+	// 177	0xb1	10110001	len	1	0	4	2
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff},
+	// 178	0xb2	10110010	len	2	0	4	2
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff},
+	// 179	0xb3	10110011	len	4	0	4	2
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff},
+	// 180	0xb4	10110100	len	0	1	4	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff},
+	// 181	0xb5	10110101	len	1	1	4	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff},
+	// 182	0xb6	10110110	len	2	1	4	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff},
+	// 183	0xb7	10110111	len	4	1	4	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0xff},
+	// 184	0xb8	10111000	len	0	2	4	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff},
+	// 185	0xb9	10111001	len	1	2	4	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff},
+	// 186	0xba	10111010	len	2	2	4	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff},
+	// 187	0xbb	10111011	len	4	2	4	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff},
+	// 188	0xbc	10111100	len	0	4	4	2
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff},
+	// 189	0xbd	10111101	len	1	4	4	2
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0xff},
+	// 190	0xbe	10111110	len	2	4	4	2
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff},
+	// 191	0xbf	10111111	len	4	4	4	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff, 0xff},
+	// 192	0xc0	11000000	len	0	0	0	4
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03},
+	// 193	0xc1	11000001	len	1	0	0	4
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04},
+	// 194	0xc2	11000010	len	2	0	0	4
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05},
+	// 195	0xc3	11000011	len	4	0	0	4
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07},
+	// 196	0xc4	11000100	len	0	1	0	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04},
+	// 197	0xc5	11000101	len	1	1	0	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05},
+	// 198	0xc6	11000110	len	2	1	0	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06},
+	// 199	0xc7	11000111	len	4	1	0	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08},
+	// 200	0xc8	11001000	len	0	2	0	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05},
+	// 201	0xc9	11001001	len	1	2	0	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06},
+	// 202	0xca	11001010	len	2	2	0	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07},
+	// 203	0xcb	11001011	len	4	2	0	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09},
+	// 204	0xcc	11001100	len	0	4	0	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07},
+	// 205	0xcd	11001101	len	1	4	0	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08},
+	// 206	0xce	11001110	len	2	4	0	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09},
+	// 207	0xcf	11001111	len	4	4	0	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 208	0xd0	11010000	len	0	0	1	4
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04},
+	// 209	0xd1	11010001	len	1	0	1	4
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05},
+	// 210	0xd2	11010010	len	2	0	1	4
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06},
+	// 211	0xd3	11010011	len	4	0	1	4
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08},
+	// 212	0xd4	11010100	len	0	1	1	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05},
+	// 213	0xd5	11010101	len	1	1	1	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06},
+	// 214	0xd6	11010110	len	2	1	1	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07},
+	// 215	0xd7	11010111	len	4	1	1	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0xff, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09},
+	// 216	0xd8	11011000	len	0	2	1	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06},
+	// 217	0xd9	11011001	len	1	2	1	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07},
+	// 218	0xda	11011010	len	2	2	1	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08},
+	// 219	0xdb	11011011	len	4	2	1	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0xff, 0xff, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 220	0xdc	11011100	len	0	4	1	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08},
+	// 221	0xdd	11011101	len	1	4	1	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09},
+	// 222	0xde	11011110	len	2	4	1	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 223	0xdf	11011111	len	4	4	1	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 224	0xe0	11100000	len	0	0	2	4
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05},
+	// 225	0xe1	11100001	len	1	0	2	4
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06},
+	// 226	0xe2	11100010	len	2	0	2	4
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07},
+	// 227	0xe3	11100011	len	4	0	2	4
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09},
+	// 228	0xe4	11100100	len	0	1	2	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06},
+	// 229	0xe5	11100101	len	1	1	2	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07},
+	// 230	0xe6	11100110	len	2	1	2	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08},
+	// 231	0xe7	11100111	len	4	1	2	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0xff, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 232	0xe8	11101000	len	0	2	2	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07},
+	// 233	0xe9	11101001	len	1	2	2	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08},
+	// 234	0xea	11101010	len	2	2	2	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09},
+	// 235	0xeb	11101011	len	4	2	2	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0xff, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 236	0xec	11101100	len	0	4	2	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09},
+	// 237	0xed	11101101	len	1	4	2	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 238	0xee	11101110	len	2	4	2	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 239	0xef	11101111	len	4	4	2	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 240	0xf0	11110000	len	0	0	4	4
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+	// 241	0xf1	11110001	len	1	0	4	4
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+	// 242	0xf2	11110010	len	2	0	4	4
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+	// 243	0xf3	11110011	len	4	0	4	4
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 244	0xf4	11110100	len	0	1	4	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+	// 245	0xf5	11110101	len	1	1	4	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+	// 246	0xf6	11110110	len	2	1	4	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a},
+	// 247	0xf7	11110111	len	4	1	4	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 248	0xf8	11111000	len	0	2	4	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+	// 249	0xf9	11111001	len	1	2	4	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a},
+	// 250	0xfa	11111010	len	2	2	4	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 251	0xfb	11111011	len	4	2	4	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 252	0xfc	11111100	len	0	4	4	4
+	{0xff, 0xff, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 253	0xfd	11111101	len	1	4	4	4
+	{0x00, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 254	0xfe	11111110	len	2	4	4	4
+	{0x00, 0x01, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 255	0xff	11111111	len	4	4	4	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+}
+
+var PerNumLenTable64 *[256][4]uint8 = &[256][4]uint8{
+	{1, 1, 1, 1}, {2, 1, 1, 1}, {4, 1, 1, 1}, {8, 1, 1, 1},
+	{1, 2, 1, 1}, {2, 2, 1, 1}, {4, 2, 1, 1}, {8, 2, 1, 1},
+	{1, 4, 1, 1}, {2, 4, 1, 1}, {4, 4, 1, 1}, {8, 4, 1, 1},
+	{1, 8, 1, 1}, {2, 8, 1, 1}, {4, 8, 1, 1}, {8, 8, 1, 1},
+	{1, 1, 2, 1}, {2, 1, 2, 1}, {4, 1, 2, 1}, {8, 1, 2, 1},
+	{1, 2, 2, 1}, {2, 2, 2, 1}, {4, 2, 2, 1}, {8, 2, 2, 1},
+	{1, 4, 2, 1}, {2, 4, 2, 1}, {4, 4, 2, 1}, {8, 4, 2, 1},
+	{1, 8, 2, 1}, {2, 8, 2, 1}, {4, 8, 2, 1}, {8, 8, 2, 1},
+	{1, 1, 4, 1}, {2, 1, 4, 1}, {4, 1, 4, 1}, {8, 1, 4, 1},
+	{1, 2, 4, 1}, {2, 2, 4, 1}, {4, 2, 4, 1}, {8, 2, 4, 1},
+	{1, 4, 4, 1}, {2, 4, 4, 1}, {4, 4, 4, 1}, {8, 4, 4, 1},
+	{1, 8, 4, 1}, {2, 8, 4, 1}, {4, 8, 4, 1}, {8, 8, 4, 1},
+	{1, 1, 8, 1}, {2, 1, 8, 1}, {4, 1, 8, 1}, {8, 1, 8, 1},
+	{1, 2, 8, 1}, {2, 2, 8, 1}, {4, 2, 8, 1}, {8, 2, 8, 1},
+	{1, 4, 8, 1}, {2, 4, 8, 1}, {4, 4, 8, 1}, {8, 4, 8, 1},
+	{1, 8, 8, 1}, {2, 8, 8, 1}, {4, 8, 8, 1}, {8, 8, 8, 1},
+	{1, 1, 1, 2}, {2, 1, 1, 2}, {4, 1, 1, 2}, {8, 1, 1, 2},
+	{1, 2, 1, 2}, {2, 2, 1, 2}, {4, 2, 1, 2}, {8, 2, 1, 2},
+	{1, 4, 1, 2}, {2, 4, 1, 2}, {4, 4, 1, 2}, {8, 4, 1, 2},
+	{1, 8, 1, 2}, {2, 8, 1, 2}, {4, 8, 1, 2}, {8, 8, 1, 2},
+	{1, 1, 2, 2}, {2, 1, 2, 2}, {4, 1, 2, 2}, {8, 1, 2, 2},
+	{1, 2, 2, 2}, {2, 2, 2, 2}, {4, 2, 2, 2}, {8, 2, 2, 2},
+	{1, 4, 2, 2}, {2, 4, 2, 2}, {4, 4, 2, 2}, {8, 4, 2, 2},
+	{1, 8, 2, 2}, {2, 8, 2, 2}, {4, 8, 2, 2}, {8, 8, 2, 2},
+	{1, 1, 4, 2}, {2, 1, 4, 2}, {4, 1, 4, 2}, {8, 1, 4, 2},
+	{1, 2, 4, 2}, {2, 2, 4, 2}, {4, 2, 4, 2}, {8, 2, 4, 2},
+	{1, 4, 4, 2}, {2, 4, 4, 2}, {4, 4, 4, 2}, {8, 4, 4, 2},
+	{1, 8, 4, 2}, {2, 8, 4, 2}, {4, 8, 4, 2}, {8, 8, 4, 2},
+	{1, 1, 8, 2}, {2, 1, 8, 2}, {4, 1, 8, 2}, {8, 1, 8, 2},
+	{1, 2, 8, 2}, {2, 2, 8, 2}, {4, 2, 8, 2}, {8, 2, 8, 2},
+	{1, 4, 8, 2}, {2, 4, 8, 2}, {4, 4, 8, 2}, {8, 4, 8, 2},
+	{1, 8, 8, 2}, {2, 8, 8, 2}, {4, 8, 8, 2}, {8, 8, 8, 2},
+	{1, 1, 1, 4}, {2, 1, 1, 4}, {4, 1, 1, 4}, {8, 1, 1, 4},
+	{1, 2, 1, 4}, {2, 2, 1, 4}, {4, 2, 1, 4}, {8, 2, 1, 4},
+	{1, 4, 1, 4}, {2, 4, 1, 4}, {4, 4, 1, 4}, {8, 4, 1, 4},
+	{1, 8, 1, 4}, {2, 8, 1, 4}, {4, 8, 1, 4}, {8, 8, 1, 4},
+	{1, 1, 2, 4}, {2, 1, 2, 4}, {4, 1, 2, 4}, {8, 1, 2, 4},
+	{1, 2, 2, 4}, {2, 2, 2, 4}, {4, 2, 2, 4}, {8, 2, 2, 4},
+	{1, 4, 2, 4}, {2, 4, 2, 4}, {4, 4, 2, 4}, {8, 4, 2, 4},
+	{1, 8, 2, 4}, {2, 8, 2, 4}, {4, 8, 2, 4}, {8, 8, 2, 4},
+	{1, 1, 4, 4}, {2, 1, 4, 4}, {4, 1, 4, 4}, {8, 1, 4, 4},
+	{1, 2, 4, 4}, {2, 2, 4, 4}, {4, 2, 4, 4}, {8, 2, 4, 4},
+	{1, 4, 4, 4}, {2, 4, 4, 4}, {4, 4, 4, 4}, {8, 4, 4, 4},
+	{1, 8, 4, 4}, {2, 8, 4, 4}, {4, 8, 4, 4}, {8, 8, 4, 4},
+	{1, 1, 8, 4}, {2, 1, 8, 4}, {4, 1, 8, 4}, {8, 1, 8, 4},
+	{1, 2, 8, 4}, {2, 2, 8, 4}, {4, 2, 8, 4}, {8, 2, 8, 4},
+	{1, 4, 8, 4}, {2, 4, 8, 4}, {4, 4, 8, 4}, {8, 4, 8, 4},
+	{1, 8, 8, 4}, {2, 8, 8, 4}, {4, 8, 8, 4}, {8, 8, 8, 4},
+	{1, 1, 1, 8}, {2, 1, 1, 8}, {4, 1, 1, 8}, {8, 1, 1, 8},
+	{1, 2, 1, 8}, {2, 2, 1, 8}, {4, 2, 1, 8}, {8, 2, 1, 8},
+	{1, 4, 1, 8}, {2, 4, 1, 8}, {4, 4, 1, 8}, {8, 4, 1, 8},
+	{1, 8, 1, 8}, {2, 8, 1, 8}, {4, 8, 1, 8}, {8, 8, 1, 8},
+	{1, 1, 2, 8}, {2, 1, 2, 8}, {4, 1, 2, 8}, {8, 1, 2, 8},
+	{1, 2, 2, 8}, {2, 2, 2, 8}, {4, 2, 2, 8}, {8, 2, 2, 8},
+	{1, 4, 2, 8}, {2, 4, 2, 8}, {4, 4, 2, 8}, {8, 4, 2, 8},
+	{1, 8, 2, 8}, {2, 8, 2, 8}, {4, 8, 2, 8}, {8, 8, 2, 8},
+	{1, 1, 4, 8}, {2, 1, 4, 8}, {4, 1, 4, 8}, {8, 1, 4, 8},
+	{1, 2, 4, 8}, {2, 2, 4, 8}, {4, 2, 4, 8}, {8, 2, 4, 8},
+	{1, 4, 4, 8}, {2, 4, 4, 8}, {4, 4, 4, 8}, {8, 4, 4, 8},
+	{1, 8, 4, 8}, {2, 8, 4, 8}, {4, 8, 4, 8}, {8, 8, 4, 8},
+	{1, 1, 8, 8}, {2, 1, 8, 8}, {4, 1, 8, 8}, {8, 1, 8, 8},
+	{1, 2, 8, 8}, {2, 2, 8, 8}, {4, 2, 8, 8}, {8, 2, 8, 8},
+	{1, 4, 8, 8}, {2, 4, 8, 8}, {4, 4, 8, 8}, {8, 4, 8, 8},
+	{1, 8, 8, 8}, {2, 8, 8, 8}, {4, 8, 8, 8}, {8, 8, 8, 8},
+}
+
+var PerControlLenTable64 *[256]uint8 = &[256]uint8{
+	4, 5, 7, 11, 5, 6, 8, 12,
+	7, 8, 10, 14, 11, 12, 14, 18,
+	5, 6, 8, 12, 6, 7, 9, 13,
+	8, 9, 11, 15, 12, 13, 15, 19,
+	7, 8, 10, 14, 8, 9, 11, 15,
+	10, 11, 13, 17, 14, 15, 17, 21,
+	11, 12, 14, 18, 12, 13, 15, 19,
+	14, 15, 17, 21, 18, 19, 21, 25,
+	5, 6, 8, 12, 6, 7, 9, 13,
+	8, 9, 11, 15, 12, 13, 15, 19,
+	6, 7, 9, 13, 7, 8, 10, 14,
+	9, 10, 12, 16, 13, 14, 16, 20,
+	8, 9, 11, 15, 9, 10, 12, 16,
+	11, 12, 14, 18, 15, 16, 18, 22,
+	12, 13, 15, 19, 13, 14, 16, 20,
+	15, 16, 18, 22, 19, 20, 22, 26,
+	7, 8, 10, 14, 8, 9, 11, 15,
+	10, 11, 13, 17, 14, 15, 17, 21,
+	8, 9, 11, 15, 9, 10, 12, 16,
+	11, 12, 14, 18, 15, 16, 18, 22,
+	10, 11, 13, 17, 11, 12, 14, 18,
+	13, 14, 16, 20, 17, 18, 20, 24,
+	14, 15, 17, 21, 15, 16, 18, 22,
+	17, 18, 20, 24, 21, 22, 24, 28,
+	11, 12, 14, 18, 12, 13, 15, 19,
+	14, 15, 17, 21, 18, 19, 21, 25,
+	12, 13, 15, 19, 13, 14, 16, 20,
+	15, 16, 18, 22, 19, 20, 22, 26,
+	14, 15, 17, 21, 15, 16, 18, 22,
+	17, 18, 20, 24, 21, 22, 24, 28,
+	18, 19, 21, 25, 19, 20, 22, 26,
+	21, 22, 24, 28, 25, 26, 28, 32,
+}
+
+var PerPairLenTable64 *[16]uint8 = &[16]uint8{
+	2, 3, 5, 9, 3, 4, 6, 10,
+	5, 6, 8, 12, 9, 10, 12, 16,
+}
+
+var EncodeShuffleTable64 *[16][16]uint8 = &[16][16]uint8{
+	// 0	0x00	0000	len	1	1
+	{0x00, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 1	0x01	0001	len	2	1
+	{0x00, 0x01, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 2	0x02	0010	len	4	1
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 3	0x03	0011	len	8	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 4	0x04	0100	len	1	2
+	{0x00, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 5	0x05	0101	len	2	2
+	{0x00, 0x01, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 6	0x06	0110	len	4	2
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 7	0x07	0111	len	8	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 8	0x08	1000	len	1	4
+	{0x00, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 9	0x09	1001	len	2	4
+	{0x00, 0x01, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 10	0x0a	1010	len	4	4
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 11	0x0b	1011	len	8	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff},
+	// 12	0x0c	1100	len	1	8
+	{0x00, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 13	0x0d	1101	len	2	8
+	{0x00, 0x01, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 14	0x0e	1110	len	4	8
+	{0x00, 0x01, 0x02, 0x03, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 15	0x0f	1111	len	8	8
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+}
+
+var DecodeShuffleTable64 *[16][16]uint8 = &[16][16]uint8{
+	// 0	0x00	0000	len	1	1
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 1	0x01	0001	len	2	1
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 2	0x02	0010	len	4	1
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 3	0x03	0011	len	8	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 4	0x04	0100	len	1	2
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 5	0x05	0101	len	2	2
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 6	0x06	0110	len	4	2
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 7	0x07	0111	len	8	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 8	0x08	1000	len	1	4
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0xff, 0xff, 0xff, 0xff},
+	// 9	0x09	1001	len	2	4
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0xff, 0xff, 0xff, 0xff},
+	// 10	0x0a	1010	len	4	4
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0xff, 0xff, 0xff, 0xff},
+	// 11	0x0b	1011	len	8	4
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0xff, 0xff, 0xff},
+	// 12	0x0c	1100	len	1	8
+	{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+	// 13	0x0d	1101	len	2	8
+	{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+	// 14	0x0e	1110	len	4	8
+	{0x00, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 15	0x0f	1111	len	8	8
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+}
+
+var PerControlLenTable16 *[256]uint8 = &[256]uint8{
+	8, 9, 9, 10, 9, 10, 10, 11,
+	9, 10, 10, 11, 10, 11, 11, 12,
+	9, 10, 10, 11, 10, 11, 11, 12,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	9, 10, 10, 11, 10, 11, 11, 12,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	9, 10, 10, 11, 10, 11, 11, 12,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	12, 13, 13, 14, 13, 14, 14, 15,
+	9, 10, 10, 11, 10, 11, 11, 12,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	12, 13, 13, 14, 13, 14, 14, 15,
+	10, 11, 11, 12, 11, 12, 12, 13,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	12, 13, 13, 14, 13, 14, 14, 15,
+	11, 12, 12, 13, 12, 13, 13, 14,
+	12, 13, 13, 14, 13, 14, 14, 15,
+	12, 13, 13, 14, 13, 14, 14, 15,
+	13, 14, 14, 15, 14, 15, 15, 16,
+}
+
+var EncodeShuffleTable16 *[256][16]uint8 = &[256][16]uint8{
+	// 0	0x00	00000000	len	1	1	1	1	1	1	1	1
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 1	0x01	00000001	len	2	1	1	1	1	1	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 2	0x02	00000010	len	1	2	1	1	1	1	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 3	0x03	00000011	len	2	2	1	1	1	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 4	0x04	00000100	len	1	1	2	1	1	1	1	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 5	0x05	00000101	len	2	1	2	1	1	1	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 6	0x06	00000110	len	1	2	2	1	1	1	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 7	0x07	00000111	len	2	2	2	1	1	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 8	0x08	00001000	len	1	1	1	2	1	1	1	1
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 9	0x09	00001001	len	2	1	1	2	1	1	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 10	0x0a	00001010	len	1	2	1	2	1	1	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 11	0x0b	00001011	len	2	2	1	2	1	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 12	0x0c	00001100	len	1	1	2	2	1	1	1	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 13	0x0d	00001101	len	2	1	2	2	1	1	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 14	0x0e	00001110	len	1	2	2	2	1	1	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 15	0x0f	00001111	len	2	2	2	2	1	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 16	0x10	00010000	len	1	1	1	1	2	1	1	1
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 17	0x11	00010001	len	2	1	1	1	2	1	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 18	0x12	00010010	len	1	2	1	1	2	1	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 19	0x13	00010011	len	2	2	1	1	2	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 20	0x14	00010100	len	1	1	2	1	2	1	1	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 21	0x15	00010101	len	2	1	2	1	2	1	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 22	0x16	00010110	len	1	2	2	1	2	1	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 23	0x17	00010111	len	2	2	2	1	2	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 24	0x18	00011000	len	1	1	1	2	2	1	1	1
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 25	0x19	00011001	len	2	1	1	2	2	1	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 26	0x1a	00011010	len	1	2	1	2	2	1	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 27	0x1b	00011011	len	2	2	1	2	2	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 28	0x1c	00011100	len	1	1	2	2	2	1	1	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 29	0x1d	00011101	len	2	1	2	2	2	1	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 30	0x1e	00011110	len	1	2	2	2	2	1	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 31	0x1f	00011111	len	2	2	2	2	2	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0xff, 0xff, 0xff},
+	// 32	0x20	00100000	len	1	1	1	1	1	2	1	1
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 33	0x21	00100001	len	2	1	1	1	1	2	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 34	0x22	00100010	len	1	2	1	1	1	2	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 35	0x23	00100011	len	2	2	1	1	1	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 36	0x24	00100100	len	1	1	2	1	1	2	1	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 37	0x25	00100101	len	2	1	2	1	1	2	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 38	0x26	00100110	len	1	2	2	1	1	2	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 39	0x27	00100111	len	2	2	2	1	1	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 40	0x28	00101000	len	1	1	1	2	1	2	1	1
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 41	0x29	00101001	len	2	1	1	2	1	2	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 42	0x2a	00101010	len	1	2	1	2	1	2	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 43	0x2b	00101011	len	2	2	1	2	1	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 44	0x2c	00101100	len	1	1	2	2	1	2	1	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 45	0x2d	00101101	len	2	1	2	2	1	2	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 46	0x2e	00101110	len	1	2	2	2	1	2	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 47	0x2f	00101111	len	2	2	2	2	1	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff},
+	// 48	0x30	00110000	len	1	1	1	1	2	2	1	1
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 49	0x31	00110001	len	2	1	1	1	2	2	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 50	0x32	00110010	len	1	2	1	1	2	2	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 51	0x33	00110011	len	2	2	1	1	2	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 52	0x34	00110100	len	1	1	2	1	2	2	1	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 53	0x35	00110101	len	2	1	2	1	2	2	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 54	0x36	00110110	len	1	2	2	1	2	2	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 55	0x37	00110111	len	2	2	2	1	2	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff},
+	// 56	0x38	00111000	len	1	1	1	2	2	2	1	1
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 57	0x39	00111001	len	2	1	1	2	2	2	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 58	0x3a	00111010	len	1	2	1	2	2	2	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 59	0x3b	00111011	len	2	2	1	2	2	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff},
+	// 60	0x3c	00111100	len	1	1	2	2	2	2	1	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 61	0x3d	00111101	len	2	1	2	2	2	2	1	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff},
+	// 62	0x3e	00111110	len	1	2	2	2	2	2	1	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff, 0xff},
+	// 63	0x3f	00111111	len	2	2	2	2	2	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0xff, 0xff},
+	// 64	0x40	01000000	len	1	1	1	1	1	1	2	1
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 65	0x41	01000001	len	2	1	1	1	1	1	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 66	0x42	01000010	len	1	2	1	1	1	1	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 67	0x43	01000011	len	2	2	1	1	1	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 68	0x44	01000100	len	1	1	2	1	1	1	2	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 69	0x45	01000101	len	2	1	2	1	1	1	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 70	0x46	01000110	len	1	2	2	1	1	1	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 71	0x47	01000111	len	2	2	2	1	1	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 72	0x48	01001000	len	1	1	1	2	1	1	2	1
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 73	0x49	01001001	len	2	1	1	2	1	1	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 74	0x4a	01001010	len	1	2	1	2	1	1	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 75	0x4b	01001011	len	2	2	1	2	1	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 76	0x4c	01001100	len	1	1	2	2	1	1	2	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 77	0x4d	01001101	len	2	1	2	2	1	1	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 78	0x4e	01001110	len	1	2	2	2	1	1	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 79	0x4f	01001111	len	2	2	2	2	1	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 80	0x50	01010000	len	1	1	1	1	2	1	2	1
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 81	0x51	01010001	len	2	1	1	1	2	1	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 82	0x52	01010010	len	1	2	1	1	2	1	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 83	0x53	01010011	len	2	2	1	1	2	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 84	0x54	01010100	len	1	1	2	1	2	1	2	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 85	0x55	01010101	len	2	1	2	1	2	1	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 86	0x56	01010110	len	1	2	2	1	2	1	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 87	0x57	01010111	len	2	2	2	1	2	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 88	0x58	01011000	len	1	1	1	2	2	1	2	1
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 89	0x59	01011001	len	2	1	1	2	2	1	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 90	0x5a	01011010	len	1	2	1	2	2	1	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 91	0x5b	01011011	len	2	2	1	2	2	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 92	0x5c	01011100	len	1	1	2	2	2	1	2	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 93	0x5d	01011101	len	2	1	2	2	2	1	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 94	0x5e	01011110	len	1	2	2	2	2	1	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 95	0x5f	01011111	len	2	2	2	2	2	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0xff, 0xff},
+	// 96	0x60	01100000	len	1	1	1	1	1	2	2	1
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 97	0x61	01100001	len	2	1	1	1	1	2	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 98	0x62	01100010	len	1	2	1	1	1	2	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 99	0x63	01100011	len	2	2	1	1	1	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 100	0x64	01100100	len	1	1	2	1	1	2	2	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 101	0x65	01100101	len	2	1	2	1	1	2	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 102	0x66	01100110	len	1	2	2	1	1	2	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 103	0x67	01100111	len	2	2	2	1	1	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 104	0x68	01101000	len	1	1	1	2	1	2	2	1
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 105	0x69	01101001	len	2	1	1	2	1	2	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 106	0x6a	01101010	len	1	2	1	2	1	2	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 107	0x6b	01101011	len	2	2	1	2	1	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 108	0x6c	01101100	len	1	1	2	2	1	2	2	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 109	0x6d	01101101	len	2	1	2	2	1	2	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 110	0x6e	01101110	len	1	2	2	2	1	2	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 111	0x6f	01101111	len	2	2	2	2	1	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff},
+	// 112	0x70	01110000	len	1	1	1	1	2	2	2	1
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 113	0x71	01110001	len	2	1	1	1	2	2	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 114	0x72	01110010	len	1	2	1	1	2	2	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 115	0x73	01110011	len	2	2	1	1	2	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 116	0x74	01110100	len	1	1	2	1	2	2	2	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 117	0x75	01110101	len	2	1	2	1	2	2	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 118	0x76	01110110	len	1	2	2	1	2	2	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 119	0x77	01110111	len	2	2	2	1	2	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff},
+	// 120	0x78	01111000	len	1	1	1	2	2	2	2	1
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff, 0xff},
+	// 121	0x79	01111001	len	2	1	1	2	2	2	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 122	0x7a	01111010	len	1	2	1	2	2	2	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 123	0x7b	01111011	len	2	2	1	2	2	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff},
+	// 124	0x7c	01111100	len	1	1	2	2	2	2	2	1
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff, 0xff},
+	// 125	0x7d	01111101	len	2	1	2	2	2	2	2	1
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff},
+	// 126	0x7e	01111110	len	1	2	2	2	2	2	2	1
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff, 0xff},
+	// 127	0x7f	01111111	len	2	2	2	2	2	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff},
+	// 128	0x80	10000000	len	1	1	1	1	1	1	1	2
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 129	0x81	10000001	len	2	1	1	1	1	1	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 130	0x82	10000010	len	1	2	1	1	1	1	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 131	0x83	10000011	len	2	2	1	1	1	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 132	0x84	10000100	len	1	1	2	1	1	1	1	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 133	0x85	10000101	len	2	1	2	1	1	1	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 134	0x86	10000110	len	1	2	2	1	1	1	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 135	0x87	10000111	len	2	2	2	1	1	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 136	0x88	10001000	len	1	1	1	2	1	1	1	2
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 137	0x89	10001001	len	2	1	1	2	1	1	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 138	0x8a	10001010	len	1	2	1	2	1	1	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 139	0x8b	10001011	len	2	2	1	2	1	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 140	0x8c	10001100	len	1	1	2	2	1	1	1	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 141	0x8d	10001101	len	2	1	2	2	1	1	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 142	0x8e	10001110	len	1	2	2	2	1	1	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 143	0x8f	10001111	len	2	2	2	2	1	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 144	0x90	10010000	len	1	1	1	1	2	1	1	2
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 145	0x91	10010001	len	2	1	1	1	2	1	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 146	0x92	10010010	len	1	2	1	1	2	1	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 147	0x93	10010011	len	2	2	1	1	2	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 148	0x94	10010100	len	1	1	2	1	2	1	1	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 149	0x95	10010101	len	2	1	2	1	2	1	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 150	0x96	10010110	len	1	2	2	1	2	1	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 151	0x97	10010111	len	2	2	2	1	2	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 152	0x98	10011000	len	1	1	1	2	2	1	1	2
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 153	0x99	10011001	len	2	1	1	2	2	1	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 154	0x9a	10011010	len	1	2	1	2	2	1	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 155	0x9b	10011011	len	2	2	1	2	2	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 156	0x9c	10011100	len	1	1	2	2	2	1	1	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 157	0x9d	10011101	len	2	1	2	2	2	1	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 158	0x9e	10011110	len	1	2	2	2	2	1	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 159	0x9f	10011111	len	2	2	2	2	2	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0e, 0x0f, 0xff, 0xff},
+	// 160	0xa0	10100000	len	1	1	1	1	1	2	1	2
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 161	0xa1	10100001	len	2	1	1	1	1	2	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 162	0xa2	10100010	len	1	2	1	1	1	2	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 163	0xa3	10100011	len	2	2	1	1	1	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 164	0xa4	10100100	len	1	1	2	1	1	2	1	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 165	0xa5	10100101	len	2	1	2	1	1	2	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 166	0xa6	10100110	len	1	2	2	1	1	2	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 167	0xa7	10100111	len	2	2	2	1	1	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 168	0xa8	10101000	len	1	1	1	2	1	2	1	2
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 169	0xa9	10101001	len	2	1	1	2	1	2	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 170	0xaa	10101010	len	1	2	1	2	1	2	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 171	0xab	10101011	len	2	2	1	2	1	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 172	0xac	10101100	len	1	1	2	2	1	2	1	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 173	0xad	10101101	len	2	1	2	2	1	2	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 174	0xae	10101110	len	1	2	2	2	1	2	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 175	0xaf	10101111	len	2	2	2	2	1	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff},
+	// 176	0xb0	10110000	len	1	1	1	1	2	2	1	2
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 177	0xb1	10110001	len	2	1	1	1	2	2	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 178	0xb2	10110010	len	1	2	1	1	2	2	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 179	0xb3	10110011	len	2	2	1	1	2	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 180	0xb4	10110100	len	1	1	2	1	2	2	1	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 181	0xb5	10110101	len	2	1	2	1	2	2	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 182	0xb6	10110110	len	1	2	2	1	2	2	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 183	0xb7	10110111	len	2	2	2	1	2	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff},
+	// 184	0xb8	10111000	len	1	1	1	2	2	2	1	2
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 185	0xb9	10111001	len	2	1	1	2	2	2	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 186	0xba	10111010	len	1	2	1	2	2	2	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 187	0xbb	10111011	len	2	2	1	2	2	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff},
+	// 188	0xbc	10111100	len	1	1	2	2	2	2	1	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 189	0xbd	10111101	len	2	1	2	2	2	2	1	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff},
+	// 190	0xbe	10111110	len	1	2	2	2	2	2	1	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff, 0xff},
+	// 191	0xbf	10111111	len	2	2	2	2	2	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0e, 0x0f, 0xff},
+	// 192	0xc0	11000000	len	1	1	1	1	1	1	2	2
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 193	0xc1	11000001	len	2	1	1	1	1	1	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 194	0xc2	11000010	len	1	2	1	1	1	1	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 195	0xc3	11000011	len	2	2	1	1	1	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 196	0xc4	11000100	len	1	1	2	1	1	1	2	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 197	0xc5	11000101	len	2	1	2	1	1	1	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 198	0xc6	11000110	len	1	2	2	1	1	1	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 199	0xc7	11000111	len	2	2	2	1	1	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 200	0xc8	11001000	len	1	1	1	2	1	1	2	2
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 201	0xc9	11001001	len	2	1	1	2	1	1	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 202	0xca	11001010	len	1	2	1	2	1	1	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 203	0xcb	11001011	len	2	2	1	2	1	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 204	0xcc	11001100	len	1	1	2	2	1	1	2	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 205	0xcd	11001101	len	2	1	2	2	1	1	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 206	0xce	11001110	len	1	2	2	2	1	1	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 207	0xcf	11001111	len	2	2	2	2	1	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 208	0xd0	11010000	len	1	1	1	1	2	1	2	2
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 209	0xd1	11010001	len	2	1	1	1	2	1	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 210	0xd2	11010010	len	1	2	1	1	2	1	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 211	0xd3	11010011	len	2	2	1	1	2	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 212	0xd4	11010100	len	1	1	2	1	2	1	2	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 213	0xd5	11010101	len	2	1	2	1	2	1	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 214	0xd6	11010110	len	1	2	2	1	2	1	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 215	0xd7	11010111	len	2	2	2	1	2	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 216	0xd8	11011000	len	1	1	1	2	2	1	2	2
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 217	0xd9	11011001	len	2	1	1	2	2	1	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 218	0xda	11011010	len	1	2	1	2	2	1	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 219	0xdb	11011011	len	2	2	1	2	2	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 220	0xdc	11011100	len	1	1	2	2	2	1	2	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 221	0xdd	11011101	len	2	1	2	2	2	1	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 222	0xde	11011110	len	1	2	2	2	2	1	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 223	0xdf	11011111	len	2	2	2	2	2	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0c, 0x0d, 0x0e, 0x0f, 0xff},
+	// 224	0xe0	11100000	len	1	1	1	1	1	2	2	2
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff, 0xff},
+	// 225	0xe1	11100001	len	2	1	1	1	1	2	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 226	0xe2	11100010	len	1	2	1	1	1	2	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 227	0xe3	11100011	len	2	2	1	1	1	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 228	0xe4	11100100	len	1	1	2	1	1	2	2	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 229	0xe5	11100101	len	2	1	2	1	1	2	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 230	0xe6	11100110	len	1	2	2	1	1	2	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 231	0xe7	11100111	len	2	2	2	1	1	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 232	0xe8	11101000	len	1	1	1	2	1	2	2	2
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 233	0xe9	11101001	len	2	1	1	2	1	2	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 234	0xea	11101010	len	1	2	1	2	1	2	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 235	0xeb	11101011	len	2	2	1	2	1	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 236	0xec	11101100	len	1	1	2	2	1	2	2	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 237	0xed	11101101	len	2	1	2	2	1	2	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 238	0xee	11101110	len	1	2	2	2	1	2	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 239	0xef	11101111	len	2	2	2	2	1	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff},
+	// 240	0xf0	11110000	len	1	1	1	1	2	2	2	2
+	{0x00, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff, 0xff},
+	// 241	0xf1	11110001	len	2	1	1	1	2	2	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 242	0xf2	11110010	len	1	2	1	1	2	2	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 243	0xf3	11110011	len	2	2	1	1	2	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 244	0xf4	11110100	len	1	1	2	1	2	2	2	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 245	0xf5	11110101	len	2	1	2	1	2	2	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 246	0xf6	11110110	len	1	2	2	1	2	2	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 247	0xf7	11110111	len	2	2	2	1	2	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff},
+	// 248	0xf8	11111000	len	1	1	1	2	2	2	2	2
+	{0x00, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff, 0xff},
+	// 249	0xf9	11111001	len	2	1	1	2	2	2	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 250	0xfa	11111010	len	1	2	1	2	2	2	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 251	0xfb	11111011	len	2	2	1	2	2	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff},
+	// 252	0xfc	11111100	len	1	1	2	2	2	2	2	2
+	{0x00, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff, 0xff},
+	// 253	0xfd	11111101	len	2	1	2	2	2	2	2	2
+	{0x00, 0x01, 0x02, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff},
+	// 254	0xfe	11111110	len	1	2	2	2	2	2	2	2
+	{0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xff},
+	// 255	0xff	11111111	len	2	2	2	2	2	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+}
+
+var DecodeShuffleTable16 *[256][16]uint8 = &[256][16]uint8{
+	// 0	0x00	00000000	len	1	1	1	1	1	1	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff},
+	// 1	0x01	00000001	len	2	1	1	1	1	1	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff},
+	// 2	0x02	00000010	len	1	2	1	1	1	1	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff},
+	// 3	0x03	00000011	len	2	2	1	1	1	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 4	0x04	00000100	len	1	1	2	1	1	1	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff},
+	// 5	0x05	00000101	len	2	1	2	1	1	1	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 6	0x06	00000110	len	1	2	2	1	1	1	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 7	0x07	00000111	len	2	2	2	1	1	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 8	0x08	00001000	len	1	1	1	2	1	1	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff},
+	// 9	0x09	00001001	len	2	1	1	2	1	1	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 10	0x0a	00001010	len	1	2	1	2	1	1	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 11	0x0b	00001011	len	2	2	1	2	1	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 12	0x0c	00001100	len	1	1	2	2	1	1	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 13	0x0d	00001101	len	2	1	2	2	1	1	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 14	0x0e	00001110	len	1	2	2	2	1	1	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 15	0x0f	00001111	len	2	2	2	2	1	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0xff},
+	// 16	0x10	00010000	len	1	1	1	1	2	1	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff},
+	// 17	0x11	00010001	len	2	1	1	1	2	1	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 18	0x12	00010010	len	1	2	1	1	2	1	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 19	0x13	00010011	len	2	2	1	1	2	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 20	0x14	00010100	len	1	1	2	1	2	1	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 21	0x15	00010101	len	2	1	2	1	2	1	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 22	0x16	00010110	len	1	2	2	1	2	1	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 23	0x17	00010111	len	2	2	2	1	2	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0xff},
+	// 24	0x18	00011000	len	1	1	1	2	2	1	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff},
+	// 25	0x19	00011001	len	2	1	1	2	2	1	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 26	0x1a	00011010	len	1	2	1	2	2	1	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 27	0x1b	00011011	len	2	2	1	2	2	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0xff},
+	// 28	0x1c	00011100	len	1	1	2	2	2	1	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff},
+	// 29	0x1d	00011101	len	2	1	2	2	2	1	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0xff},
+	// 30	0x1e	00011110	len	1	2	2	2	2	1	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0xff},
+	// 31	0x1f	00011111	len	2	2	2	2	2	1	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff, 0x0c, 0xff},
+	// 32	0x20	00100000	len	1	1	1	1	1	2	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff},
+	// 33	0x21	00100001	len	2	1	1	1	1	2	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff},
+	// 34	0x22	00100010	len	1	2	1	1	1	2	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff},
+	// 35	0x23	00100011	len	2	2	1	1	1	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 36	0x24	00100100	len	1	1	2	1	1	2	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff},
+	// 37	0x25	00100101	len	2	1	2	1	1	2	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 38	0x26	00100110	len	1	2	2	1	1	2	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 39	0x27	00100111	len	2	2	2	1	1	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 40	0x28	00101000	len	1	1	1	2	1	2	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff},
+	// 41	0x29	00101001	len	2	1	1	2	1	2	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 42	0x2a	00101010	len	1	2	1	2	1	2	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 43	0x2b	00101011	len	2	2	1	2	1	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 44	0x2c	00101100	len	1	1	2	2	1	2	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 45	0x2d	00101101	len	2	1	2	2	1	2	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 46	0x2e	00101110	len	1	2	2	2	1	2	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 47	0x2f	00101111	len	2	2	2	2	1	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0xff},
+	// 48	0x30	00110000	len	1	1	1	1	2	2	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff},
+	// 49	0x31	00110001	len	2	1	1	1	2	2	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 50	0x32	00110010	len	1	2	1	1	2	2	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 51	0x33	00110011	len	2	2	1	1	2	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 52	0x34	00110100	len	1	1	2	1	2	2	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 53	0x35	00110101	len	2	1	2	1	2	2	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 54	0x36	00110110	len	1	2	2	1	2	2	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 55	0x37	00110111	len	2	2	2	1	2	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0xff},
+	// 56	0x38	00111000	len	1	1	1	2	2	2	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff},
+	// 57	0x39	00111001	len	2	1	1	2	2	2	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 58	0x3a	00111010	len	1	2	1	2	2	2	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 59	0x3b	00111011	len	2	2	1	2	2	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0xff},
+	// 60	0x3c	00111100	len	1	1	2	2	2	2	1	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff},
+	// 61	0x3d	00111101	len	2	1	2	2	2	2	1	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0xff},
+	// 62	0x3e	00111110	len	1	2	2	2	2	2	1	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0xff},
+	// 63	0x3f	00111111	len	2	2	2	2	2	2	1	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0x0d, 0xff},
+	// 64	0x40	01000000	len	1	1	1	1	1	1	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff},
+	// 65	0x41	01000001	len	2	1	1	1	1	1	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff},
+	// 66	0x42	01000010	len	1	2	1	1	1	1	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff},
+	// 67	0x43	01000011	len	2	2	1	1	1	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 68	0x44	01000100	len	1	1	2	1	1	1	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff},
+	// 69	0x45	01000101	len	2	1	2	1	1	1	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 70	0x46	01000110	len	1	2	2	1	1	1	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 71	0x47	01000111	len	2	2	2	1	1	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 72	0x48	01001000	len	1	1	1	2	1	1	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff},
+	// 73	0x49	01001001	len	2	1	1	2	1	1	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 74	0x4a	01001010	len	1	2	1	2	1	1	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 75	0x4b	01001011	len	2	2	1	2	1	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 76	0x4c	01001100	len	1	1	2	2	1	1	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 77	0x4d	01001101	len	2	1	2	2	1	1	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 78	0x4e	01001110	len	1	2	2	2	1	1	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 79	0x4f	01001111	len	2	2	2	2	1	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0xff},
+	// 80	0x50	01010000	len	1	1	1	1	2	1	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff},
+	// 81	0x51	01010001	len	2	1	1	1	2	1	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 82	0x52	01010010	len	1	2	1	1	2	1	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 83	0x53	01010011	len	2	2	1	1	2	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 84	0x54	01010100	len	1	1	2	1	2	1	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 85	0x55	01010101	len	2	1	2	1	2	1	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 86	0x56	01010110	len	1	2	2	1	2	1	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 87	0x57	01010111	len	2	2	2	1	2	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0xff},
+	// 88	0x58	01011000	len	1	1	1	2	2	1	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff},
+	// 89	0x59	01011001	len	2	1	1	2	2	1	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 90	0x5a	01011010	len	1	2	1	2	2	1	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 91	0x5b	01011011	len	2	2	1	2	2	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0xff},
+	// 92	0x5c	01011100	len	1	1	2	2	2	1	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff},
+	// 93	0x5d	01011101	len	2	1	2	2	2	1	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0xff},
+	// 94	0x5e	01011110	len	1	2	2	2	2	1	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0xff},
+	// 95	0x5f	01011111	len	2	2	2	2	2	1	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c, 0x0d, 0xff},
+	// 96	0x60	01100000	len	1	1	1	1	1	2	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff},
+	// 97	0x61	01100001	len	2	1	1	1	1	2	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff},
+	// 98	0x62	01100010	len	1	2	1	1	1	2	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff},
+	// 99	0x63	01100011	len	2	2	1	1	1	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 100	0x64	01100100	len	1	1	2	1	1	2	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff},
+	// 101	0x65	01100101	len	2	1	2	1	1	2	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 102	0x66	01100110	len	1	2	2	1	1	2	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 103	0x67	01100111	len	2	2	2	1	1	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 104	0x68	01101000	len	1	1	1	2	1	2	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff},
+	// 105	0x69	01101001	len	2	1	1	2	1	2	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 106	0x6a	01101010	len	1	2	1	2	1	2	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 107	0x6b	01101011	len	2	2	1	2	1	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 108	0x6c	01101100	len	1	1	2	2	1	2	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 109	0x6d	01101101	len	2	1	2	2	1	2	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 110	0x6e	01101110	len	1	2	2	2	1	2	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 111	0x6f	01101111	len	2	2	2	2	1	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff},
+	// 112	0x70	01110000	len	1	1	1	1	2	2	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff},
+	// 113	0x71	01110001	len	2	1	1	1	2	2	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 114	0x72	01110010	len	1	2	1	1	2	2	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 115	0x73	01110011	len	2	2	1	1	2	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 116	0x74	01110100	len	1	1	2	1	2	2	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 117	0x75	01110101	len	2	1	2	1	2	2	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 118	0x76	01110110	len	1	2	2	1	2	2	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 119	0x77	01110111	len	2	2	2	1	2	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff},
+	// 120	0x78	01111000	len	1	1	1	2	2	2	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff},
+	// 121	0x79	01111001	len	2	1	1	2	2	2	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 122	0x7a	01111010	len	1	2	1	2	2	2	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 123	0x7b	01111011	len	2	2	1	2	2	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff},
+	// 124	0x7c	01111100	len	1	1	2	2	2	2	2	1
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff},
+	// 125	0x7d	01111101	len	2	1	2	2	2	2	2	1
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff},
+	// 126	0x7e	01111110	len	1	2	2	2	2	2	2	1
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0xff},
+	// 127	0x7f	01111111	len	2	2	2	2	2	2	2	1
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0xff},
+	// 128	0x80	10000000	len	1	1	1	1	1	1	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08},
+	// 129	0x81	10000001	len	2	1	1	1	1	1	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09},
+	// 130	0x82	10000010	len	1	2	1	1	1	1	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09},
+	// 131	0x83	10000011	len	2	2	1	1	1	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 132	0x84	10000100	len	1	1	2	1	1	1	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09},
+	// 133	0x85	10000101	len	2	1	2	1	1	1	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 134	0x86	10000110	len	1	2	2	1	1	1	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 135	0x87	10000111	len	2	2	2	1	1	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 136	0x88	10001000	len	1	1	1	2	1	1	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09},
+	// 137	0x89	10001001	len	2	1	1	2	1	1	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 138	0x8a	10001010	len	1	2	1	2	1	1	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 139	0x8b	10001011	len	2	2	1	2	1	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 140	0x8c	10001100	len	1	1	2	2	1	1	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 141	0x8d	10001101	len	2	1	2	2	1	1	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 142	0x8e	10001110	len	1	2	2	2	1	1	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 143	0x8f	10001111	len	2	2	2	2	1	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0x0c},
+	// 144	0x90	10010000	len	1	1	1	1	2	1	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09},
+	// 145	0x91	10010001	len	2	1	1	1	2	1	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 146	0x92	10010010	len	1	2	1	1	2	1	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 147	0x93	10010011	len	2	2	1	1	2	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 148	0x94	10010100	len	1	1	2	1	2	1	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 149	0x95	10010101	len	2	1	2	1	2	1	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 150	0x96	10010110	len	1	2	2	1	2	1	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 151	0x97	10010111	len	2	2	2	1	2	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0x0c},
+	// 152	0x98	10011000	len	1	1	1	2	2	1	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a},
+	// 153	0x99	10011001	len	2	1	1	2	2	1	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 154	0x9a	10011010	len	1	2	1	2	2	1	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 155	0x9b	10011011	len	2	2	1	2	2	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0x0c},
+	// 156	0x9c	10011100	len	1	1	2	2	2	1	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b},
+	// 157	0x9d	10011101	len	2	1	2	2	2	1	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0x0c},
+	// 158	0x9e	10011110	len	1	2	2	2	2	1	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0xff, 0x0b, 0x0c},
+	// 159	0x9f	10011111	len	2	2	2	2	2	1	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0xff, 0x0c, 0x0d},
+	// 160	0xa0	10100000	len	1	1	1	1	1	2	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09},
+	// 161	0xa1	10100001	len	2	1	1	1	1	2	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a},
+	// 162	0xa2	10100010	len	1	2	1	1	1	2	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a},
+	// 163	0xa3	10100011	len	2	2	1	1	1	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 164	0xa4	10100100	len	1	1	2	1	1	2	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a},
+	// 165	0xa5	10100101	len	2	1	2	1	1	2	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 166	0xa6	10100110	len	1	2	2	1	1	2	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 167	0xa7	10100111	len	2	2	2	1	1	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 168	0xa8	10101000	len	1	1	1	2	1	2	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a},
+	// 169	0xa9	10101001	len	2	1	1	2	1	2	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 170	0xaa	10101010	len	1	2	1	2	1	2	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 171	0xab	10101011	len	2	2	1	2	1	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 172	0xac	10101100	len	1	1	2	2	1	2	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 173	0xad	10101101	len	2	1	2	2	1	2	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 174	0xae	10101110	len	1	2	2	2	1	2	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 175	0xaf	10101111	len	2	2	2	2	1	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0x0d},
+	// 176	0xb0	10110000	len	1	1	1	1	2	2	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a},
+	// 177	0xb1	10110001	len	2	1	1	1	2	2	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 178	0xb2	10110010	len	1	2	1	1	2	2	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 179	0xb3	10110011	len	2	2	1	1	2	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 180	0xb4	10110100	len	1	1	2	1	2	2	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 181	0xb5	10110101	len	2	1	2	1	2	2	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 182	0xb6	10110110	len	1	2	2	1	2	2	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 183	0xb7	10110111	len	2	2	2	1	2	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0x0d},
+	// 184	0xb8	10111000	len	1	1	1	2	2	2	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b},
+	// 185	0xb9	10111001	len	2	1	1	2	2	2	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 186	0xba	10111010	len	1	2	1	2	2	2	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 187	0xbb	10111011	len	2	2	1	2	2	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0x0d},
+	// 188	0xbc	10111100	len	1	1	2	2	2	2	1	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c},
+	// 189	0xbd	10111101	len	2	1	2	2	2	2	1	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0x0d},
+	// 190	0xbe	10111110	len	1	2	2	2	2	2	1	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0xff, 0x0c, 0x0d},
+	// 191	0xbf	10111111	len	2	2	2	2	2	2	1	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0xff, 0x0d, 0x0e},
+	// 192	0xc0	11000000	len	1	1	1	1	1	1	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09},
+	// 193	0xc1	11000001	len	2	1	1	1	1	1	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 194	0xc2	11000010	len	1	2	1	1	1	1	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 195	0xc3	11000011	len	2	2	1	1	1	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 196	0xc4	11000100	len	1	1	2	1	1	1	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 197	0xc5	11000101	len	2	1	2	1	1	1	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 198	0xc6	11000110	len	1	2	2	1	1	1	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 199	0xc7	11000111	len	2	2	2	1	1	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 200	0xc8	11001000	len	1	1	1	2	1	1	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 201	0xc9	11001001	len	2	1	1	2	1	1	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 202	0xca	11001010	len	1	2	1	2	1	1	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 203	0xcb	11001011	len	2	2	1	2	1	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 204	0xcc	11001100	len	1	1	2	2	1	1	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 205	0xcd	11001101	len	2	1	2	2	1	1	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 206	0xce	11001110	len	1	2	2	2	1	1	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 207	0xcf	11001111	len	2	2	2	2	1	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 208	0xd0	11010000	len	1	1	1	1	2	1	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a},
+	// 209	0xd1	11010001	len	2	1	1	1	2	1	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 210	0xd2	11010010	len	1	2	1	1	2	1	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 211	0xd3	11010011	len	2	2	1	1	2	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 212	0xd4	11010100	len	1	1	2	1	2	1	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 213	0xd5	11010101	len	2	1	2	1	2	1	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 214	0xd6	11010110	len	1	2	2	1	2	1	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 215	0xd7	11010111	len	2	2	2	1	2	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 216	0xd8	11011000	len	1	1	1	2	2	1	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b},
+	// 217	0xd9	11011001	len	2	1	1	2	2	1	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 218	0xda	11011010	len	1	2	1	2	2	1	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 219	0xdb	11011011	len	2	2	1	2	2	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 220	0xdc	11011100	len	1	1	2	2	2	1	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c},
+	// 221	0xdd	11011101	len	2	1	2	2	2	1	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 222	0xde	11011110	len	1	2	2	2	2	1	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0xff, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 223	0xdf	11011111	len	2	2	2	2	2	1	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0xff, 0x0b, 0x0c, 0x0d, 0x0e},
+	// 224	0xe0	11100000	len	1	1	1	1	1	2	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a},
+	// 225	0xe1	11100001	len	2	1	1	1	1	2	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 226	0xe2	11100010	len	1	2	1	1	1	2	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 227	0xe3	11100011	len	2	2	1	1	1	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 228	0xe4	11100100	len	1	1	2	1	1	2	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 229	0xe5	11100101	len	2	1	2	1	1	2	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 230	0xe6	11100110	len	1	2	2	1	1	2	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 231	0xe7	11100111	len	2	2	2	1	1	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 232	0xe8	11101000	len	1	1	1	2	1	2	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 233	0xe9	11101001	len	2	1	1	2	1	2	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 234	0xea	11101010	len	1	2	1	2	1	2	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 235	0xeb	11101011	len	2	2	1	2	1	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 236	0xec	11101100	len	1	1	2	2	1	2	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 237	0xed	11101101	len	2	1	2	2	1	2	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 238	0xee	11101110	len	1	2	2	2	1	2	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 239	0xef	11101111	len	2	2	2	2	1	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xff, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e},
+	// 240	0xf0	11110000	len	1	1	1	1	2	2	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	// 241	0xf1	11110001	len	2	1	1	1	2	2	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 242	0xf2	11110010	len	1	2	1	1	2	2	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 243	0xf3	11110011	len	2	2	1	1	2	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 244	0xf4	11110100	len	1	1	2	1	2	2	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 245	0xf5	11110101	len	2	1	2	1	2	2	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 246	0xf6	11110110	len	1	2	2	1	2	2	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0xff, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 247	0xf7	11110111	len	2	2	2	1	2	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0xff, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e},
+	// 248	0xf8	11111000	len	1	1	1	2	2	2	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+	// 249	0xf9	11111001	len	2	1	1	2	2	2	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 250	0xfa	11111010	len	1	2	1	2	2	2	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0xff, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 251	0xfb	11111011	len	2	2	1	2	2	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0xff, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e},
+	// 252	0xfc	11111100	len	1	1	2	2	2	2	2	2
+	{0x00, 0xff, 0x01, 0xff, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+	// 253	0xfd	11111101	len	2	1	2	2	2	2	2	2
+	{0x00, 0x01, 0x02, 0xff, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e},
+	// 254	0xfe	11111110	len	1	2	2	2	2	2	2	2
+	{0x00, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e},
+	// 255	0xff	11111111	len	2	2	2	2	2	2	2	2
+	{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+}
@@ -0,0 +1,36 @@
+package shared
+
+import "errors"
+
+// Tier identifies which kernels are used by the encoding and decoding
+// packages on x86_64. Higher tiers are faster, and each package picks the
+// highest tier supported by the CPU during package initialization.
+type Tier int
+
+const (
+	// TierScalar uses the portable Go implementation.
+	TierScalar Tier = iota
+	// TierSSE41 uses the legacy (non VEX encoded) SSE kernels of the
+	// regular and differential 32-bit algorithms and the portable Go
+	// implementation for everything else.
+	TierSSE41
+	// TierAVX2 uses the VEX encoded kernels for everything. The decoding
+	// kernels only require AVX.
+	TierAVX2
+)
+
+// ErrUnsupportedTier indicates that the CPU lacks the instructions needed
+// by the requested tier.
+var ErrUnsupportedTier = errors.New("streamvbyte: tier not supported by the CPU")
+
+func (t Tier) String() string {
+	switch t {
+	case TierScalar:
+		return "scalar"
+	case TierSSE41:
+		return "sse4.1"
+	case TierAVX2:
+		return "avx2"
+	}
+	return "unknown"
+}
@@ -0,0 +1,25 @@
+package shared
+
+// The trailer format lets a writer emit the data bytes of a Stream VByte
+// stream as the integers are produced, without knowing their count up
+// front. The data bytes come first, followed by the (count+3)/4 control
+// bytes and a footer of TrailerFooterLen bytes, from which a reader
+// locates everything else:
+//
+// Count:   8 bytes, little endian count of integers
+// Prev:    4 bytes, little endian prev used for differential coding
+// Flags:   1 byte
+// Magic:   3 bytes, TrailerMagic
+const (
+	// TrailerFooterLen is the length of the footer ending a stream in the
+	// trailer format.
+	TrailerFooterLen = 16
+
+	// TrailerMagic ends the footer of a stream in the trailer format.
+	TrailerMagic = "svt"
+
+	// TrailerFlagDelta indicates that the integers of a stream in the
+	// trailer format are differentially coded, starting from the prev
+	// stored in its footer.
+	TrailerFlagDelta = 1 << 0
+)
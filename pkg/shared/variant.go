@@ -0,0 +1,43 @@
+package shared
+
+// Variant identifies how the 2-bit codes of a Stream VByte stream map onto
+// the byte lengths of the encoded integers. Both variants share the same
+// stream layout, i.e. control bytes followed by data bytes.
+type Variant uint8
+
+const (
+	// VariantStandard maps the 2-bit codes onto 1, 2, 3 and 4 bytes.
+	VariantStandard Variant = iota
+	// Variant0124 maps the 2-bit codes onto 0, 1, 2 and 4 bytes, thus
+	// zeros take up no data bytes at all. It is byte compatible with the
+	// streamvbyte_0124 functions of the reference C implementation.
+	Variant0124
+)
+
+// PerNumLenTable returns the table mapping a control byte onto the length
+// of each of its four integers.
+func (v Variant) PerNumLenTable() *[256][4]uint8 {
+	if v == Variant0124 {
+		return PerNumLenTable0124
+	}
+	return PerNumLenTable
+}
+
+// PerControlLenTable returns the table mapping a control byte onto the
+// length of all four of its integers.
+func (v Variant) PerControlLenTable() *[256]uint8 {
+	if v == Variant0124 {
+		return PerControlLenTable0124
+	}
+	return PerControlLenTable
+}
+
+func (v Variant) String() string {
+	switch v {
+	case VariantStandard:
+		return "standard"
+	case Variant0124:
+		return "0124"
+	}
+	return "unknown"
+}
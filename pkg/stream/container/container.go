@@ -0,0 +1,451 @@
+// Package container provides a self-describing, versioned wrapper around
+// Stream VByte streams. A container records everything needed to decode
+// its contents, i.e. the count of integers and how they were coded, and can
+// optionally protect its contents with CRC32C checksums.
+//
+// All fields are little endian. The header is laid out as follows:
+//
+//	magic    [4]byte "SVBC"
+//	version  uint8
+//	flags    uint8
+//	variant  uint8
+//	reserved uint8
+//	count    uint64
+//	prev     uint32
+//	blockLen uint32
+//	checksum uint32 (only present if FlagChecksum is set)
+//
+// The header is followed by ceil(count / blockLen) blocks, each holding up
+// to blockLen integers encoded as a Stream VByte stream of the variant
+// recorded in the header. A block is prefixed by its uint32 byte length
+// and, if FlagChecksum is set, the CRC32C of its bytes. For differentially
+// coded containers, the prev of each block is the last integer of the
+// previous block.
+package container
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/stream/reader"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/stream/writer"
+)
+
+const (
+	// Version is the container format version written by Marshal.
+	Version = 1
+
+	// DefaultBlockLen is the count of integers per block used when none
+	// is provided.
+	DefaultBlockLen = 1 << 16
+
+	// MaxBlockLen is the largest count of integers per block, which fits
+	// into the uint32 blockLen of the header as well as into an int.
+	MaxBlockLen = math.MaxInt32
+
+	headerLen = 24
+)
+
+const (
+	// FlagDelta indicates that the integers are differentially coded.
+	FlagDelta uint8 = 1 << iota
+	// FlagChecksum indicates that the header and every block are followed
+	// by a CRC32C checksum.
+	FlagChecksum
+	// FlagZigzag indicates that the integers are signed and were zigzag
+	// encoded, see MarshalInt32.
+	FlagZigzag
+)
+
+const (
+	// VariantStandard is the regular Stream VByte format where every
+	// integer is encoded with 1, 2, 3 or 4 bytes.
+	VariantStandard = uint8(shared.VariantStandard)
+	// Variant0124 is the "0124" Stream VByte format where every integer
+	// is encoded with 0, 1, 2 or 4 bytes.
+	Variant0124 = uint8(shared.Variant0124)
+)
+
+var (
+	magic = [4]byte{'S', 'V', 'B', 'C'}
+
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+)
+
+var (
+	// ErrInvalidMagic indicates that the data is not a container.
+	ErrInvalidMagic = errors.New("streamvbyte: invalid container magic")
+
+	// ErrUnsupported indicates that the container uses a version, flag or
+	// variant that this package does not know how to decode.
+	ErrUnsupported = errors.New("streamvbyte: unsupported container")
+
+	// ErrCorrupt indicates that the container is malformed.
+	ErrCorrupt = errors.New("streamvbyte: corrupt container")
+
+	// ErrChecksum indicates that a checksum did not match its contents.
+	ErrChecksum = errors.New("streamvbyte: checksum mismatch")
+)
+
+// Options configures how Marshal encodes the integers.
+type Options struct {
+	// Delta enables differential coding using Prev as the base value.
+	Delta bool
+	Prev  uint32
+
+	// Checksum enables CRC32C checksums for the header and every block.
+	Checksum bool
+
+	// BlockLen is the count of integers per block. Values less than 1
+	// select DefaultBlockLen and values greater than MaxBlockLen are
+	// capped.
+	BlockLen int
+
+	// Variant selects the Stream VByte variant used to encode the blocks
+	// and must be VariantStandard or Variant0124.
+	Variant uint8
+}
+
+// Header describes the contents of a container.
+type Header struct {
+	Version  uint8
+	Flags    uint8
+	Variant  uint8
+	Count    int
+	Prev     uint32
+	BlockLen int
+}
+
+// Delta reports whether the integers are differentially coded.
+func (h Header) Delta() bool {
+	return h.Flags&FlagDelta != 0
+}
+
+// Checksum reports whether the container holds checksums.
+func (h Header) Checksum() bool {
+	return h.Flags&FlagChecksum != 0
+}
+
+// Zigzag reports whether the integers are signed and zigzag encoded.
+func (h Header) Zigzag() bool {
+	return h.Flags&FlagZigzag != 0
+}
+
+// Marshal encodes in into a new container. It panics if opts.Variant is
+// not a known variant.
+func Marshal(in []uint32, opts Options) []byte {
+	prev := opts.Prev
+	return marshal(len(in), opts, 0, func(out []byte, h Header, start, end int) []byte {
+		out = appendBlock(out, h, in[start:end], prev)
+		prev = in[end-1]
+		return out
+	})
+}
+
+// MarshalInt32 zigzag encodes in and encodes the result into a new
+// container, which must be decoded with UnmarshalInt32. With opts.Delta the
+// zigzag encoded integers are differentially coded. Like Marshal, it panics
+// if opts.Variant is not a known variant.
+func MarshalInt32(in []int32, opts Options) []byte {
+	if !opts.Delta && opts.Variant == VariantStandard {
+		return marshal(len(in), opts, FlagZigzag, func(out []byte, h Header, start, end int) []byte {
+			return append(out, writer.WriteAllInt32(in[start:end])...)
+		})
+	}
+
+	// There are no kernels zigzag encoding into the other codings, so every
+	// block is zigzag encoded up front.
+	var (
+		prev      = opts.Prev
+		zigzagged []uint32
+	)
+	return marshal(len(in), opts, FlagZigzag, func(out []byte, h Header, start, end int) []byte {
+		if zigzagged == nil {
+			zigzagged = make([]uint32, end-start)
+		}
+		nums := zigzagged[:end-start]
+		for i, num := range in[start:end] {
+			nums[i] = uint32((num >> 31) ^ (num << 1))
+		}
+		out = appendBlock(out, h, nums, prev)
+		prev = nums[len(nums)-1]
+		return out
+	})
+}
+
+// marshal encodes count integers into a new container whose header has
+// flags set in addition to the ones selected by opts. appendBlock appends
+// the encoding of the integers from start to end to out.
+func marshal(count int, opts Options, flags uint8, appendBlock func(out []byte, h Header, start, end int) []byte) []byte {
+	if opts.Variant != VariantStandard && opts.Variant != Variant0124 {
+		panic(fmt.Sprintf("streamvbyte: unknown container variant %d", opts.Variant))
+	}
+
+	h := Header{
+		Version:  Version,
+		Flags:    flags,
+		Variant:  opts.Variant,
+		Count:    count,
+		Prev:     opts.Prev,
+		BlockLen: opts.BlockLen,
+	}
+	if h.BlockLen < 1 {
+		h.BlockLen = DefaultBlockLen
+	}
+	if h.BlockLen > MaxBlockLen {
+		h.BlockLen = MaxBlockLen
+	}
+	if opts.Delta {
+		h.Flags |= FlagDelta
+	}
+	if opts.Checksum {
+		h.Flags |= FlagChecksum
+	}
+
+	out := appendHeader(make([]byte, 0, headerLen+4+writer.MaxEncodedLen(count)), h)
+	for start := 0; start < count; start += h.BlockLen {
+		end := start + h.BlockLen
+		if end > count {
+			end = count
+		}
+
+		pos := len(out)
+		out = append(out, make([]byte, blockPrefixLen(h))...)
+		out = appendBlock(out, h, start, end)
+
+		block := out[pos+blockPrefixLen(h):]
+		binary.LittleEndian.PutUint32(out[pos:], uint32(len(block)))
+		if h.Checksum() {
+			binary.LittleEndian.PutUint32(out[pos+4:], crc32.Checksum(block, castagnoli))
+		}
+	}
+
+	return out
+}
+
+// Unmarshal decodes the integers held in the container data. Containers
+// of signed integers must be decoded with UnmarshalInt32 instead.
+func Unmarshal(data []byte) ([]uint32, error) {
+	h, err := ReadHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if h.Zigzag() {
+		return nil, fmt.Errorf("%w: signed integers", ErrUnsupported)
+	}
+
+	out := make([]uint32, h.Count)
+	prev := h.Prev
+	err = decodeBlocks(h, data[HeaderLen(h):], func(block []byte, start, end int) error {
+		err := decodeBlock(h, block, out[start:end], prev)
+		prev = out[end-1]
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UnmarshalInt32 decodes the signed integers held in the container data
+// written by MarshalInt32.
+func UnmarshalInt32(data []byte) ([]int32, error) {
+	h, err := ReadHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if !h.Zigzag() {
+		return nil, fmt.Errorf("%w: unsigned integers", ErrUnsupported)
+	}
+
+	out := make([]int32, h.Count)
+	body := data[HeaderLen(h):]
+	if !h.Delta() && h.Variant == VariantStandard {
+		err = decodeBlocks(h, body, func(block []byte, start, end int) error {
+			_, err := reader.ReadAllInt32Checked(end-start, block, out[start:end])
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
+	var (
+		prev      = h.Prev
+		zigzagged []uint32
+	)
+	err = decodeBlocks(h, body, func(block []byte, start, end int) error {
+		if zigzagged == nil {
+			zigzagged = make([]uint32, end-start)
+		}
+		nums := zigzagged[:end-start]
+		if err := decodeBlock(h, block, nums, prev); err != nil {
+			return err
+		}
+		prev = nums[len(nums)-1]
+		for i, num := range nums {
+			out[start+i] = int32(num>>1) ^ -int32(num&1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadHeader parses and validates the header of the container data.
+func ReadHeader(data []byte) (Header, error) {
+	if len(data) < headerLen {
+		return Header{}, fmt.Errorf("%w: short header", ErrCorrupt)
+	}
+	if [4]byte{data[0], data[1], data[2], data[3]} != magic {
+		return Header{}, ErrInvalidMagic
+	}
+
+	h := Header{
+		Version:  data[4],
+		Flags:    data[5],
+		Variant:  data[6],
+		Count:    int(binary.LittleEndian.Uint64(data[8:])),
+		Prev:     binary.LittleEndian.Uint32(data[16:]),
+		BlockLen: int(binary.LittleEndian.Uint32(data[20:])),
+	}
+
+	if h.Version != Version {
+		return Header{}, fmt.Errorf("%w: version %d", ErrUnsupported, h.Version)
+	}
+	if h.Flags&^(FlagDelta|FlagChecksum|FlagZigzag) != 0 {
+		return Header{}, fmt.Errorf("%w: flags %#02x", ErrUnsupported, h.Flags)
+	}
+	if h.Variant != VariantStandard && h.Variant != Variant0124 {
+		return Header{}, fmt.Errorf("%w: variant %d", ErrUnsupported, h.Variant)
+	}
+
+	if h.Checksum() {
+		if len(data) < headerLen+4 {
+			return Header{}, fmt.Errorf("%w: short header", ErrCorrupt)
+		}
+		if crc32.Checksum(data[:headerLen], castagnoli) != binary.LittleEndian.Uint32(data[headerLen:]) {
+			return Header{}, fmt.Errorf("%w: header", ErrChecksum)
+		}
+	}
+
+	// Every integer takes up at least one data byte, or a quarter of a
+	// control byte for the "0124" variant, which bounds the count by the
+	// size of the container.
+	maxCount := len(data)
+	if h.Variant == Variant0124 {
+		maxCount *= 4
+	}
+	if h.Count < 0 || h.Count > maxCount || h.BlockLen < 1 {
+		return Header{}, fmt.Errorf("%w: invalid count %d", ErrCorrupt, h.Count)
+	}
+
+	return h, nil
+}
+
+// HeaderLen returns the byte length of the header described by h.
+func HeaderLen(h Header) int {
+	if h.Checksum() {
+		return headerLen + 4
+	}
+	return headerLen
+}
+
+func appendHeader(out []byte, h Header) []byte {
+	var buf [headerLen]byte
+	copy(buf[:], magic[:])
+	buf[4] = h.Version
+	buf[5] = h.Flags
+	buf[6] = h.Variant
+	binary.LittleEndian.PutUint64(buf[8:], uint64(h.Count))
+	binary.LittleEndian.PutUint32(buf[16:], h.Prev)
+	binary.LittleEndian.PutUint32(buf[20:], uint32(h.BlockLen))
+
+	out = append(out, buf[:]...)
+	if h.Checksum() {
+		var sum [4]byte
+		binary.LittleEndian.PutUint32(sum[:], crc32.Checksum(buf[:], castagnoli))
+		out = append(out, sum[:]...)
+	}
+	return out
+}
+
+// appendBlock appends the Stream VByte encoding of in to out according to
+// the coding described by h.
+func appendBlock(out []byte, h Header, in []uint32, prev uint32) []byte {
+	if h.Variant == Variant0124 {
+		if h.Delta() {
+			return append(out, writer.WriteAllDeltaVariant(in, prev, shared.Variant0124)...)
+		}
+		return append(out, writer.WriteAllVariant(in, shared.Variant0124)...)
+	}
+
+	if h.Delta() {
+		return writer.AppendAllDelta(out, in, prev)
+	}
+	return writer.AppendAll(out, in)
+}
+
+func blockPrefixLen(h Header) int {
+	if h.Checksum() {
+		return 8
+	}
+	return 4
+}
+
+// decodeBlocks hands every block in body over to decodeBlock along with the
+// range of integers it holds, verifying checksums when present.
+func decodeBlocks(h Header, body []byte, decodeBlock func(block []byte, start, end int) error) error {
+	prefixLen := blockPrefixLen(h)
+	for start := 0; start < h.Count; start += h.BlockLen {
+		end := start + h.BlockLen
+		if end > h.Count {
+			end = h.Count
+		}
+
+		if len(body) < prefixLen {
+			return fmt.Errorf("%w: short block header", ErrCorrupt)
+		}
+		size := int(binary.LittleEndian.Uint32(body))
+		if size > len(body)-prefixLen {
+			return fmt.Errorf("%w: short block", ErrCorrupt)
+		}
+		block := body[prefixLen : prefixLen+size]
+		if h.Checksum() && crc32.Checksum(block, castagnoli) != binary.LittleEndian.Uint32(body[4:]) {
+			return fmt.Errorf("%w: block at %d", ErrChecksum, start)
+		}
+
+		if err := decodeBlock(block, start, end); err != nil {
+			return fmt.Errorf("%w: %v", ErrCorrupt, err)
+		}
+
+		body = body[prefixLen+size:]
+	}
+
+	if len(body) != 0 {
+		return fmt.Errorf("%w: trailing bytes", ErrCorrupt)
+	}
+	return nil
+}
+
+// decodeBlock decodes the Stream VByte encoded block into out according to
+// the coding described by h.
+func decodeBlock(h Header, block []byte, out []uint32, prev uint32) error {
+	var (
+		err     error
+		variant = shared.Variant(h.Variant)
+	)
+	if h.Delta() {
+		_, err = reader.ReadAllDeltaVariantChecked(len(out), block, out, prev, variant)
+	} else {
+		_, err = reader.ReadAllVariantChecked(len(out), block, out, variant)
+	}
+	return err
+}
@@ -0,0 +1,154 @@
+package container
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/util"
+)
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+}
+
+func TestRoundTrip(t *testing.T) {
+	for i := 0; i < 8; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		opts := Options{
+			Delta:    i&1 != 0,
+			Checksum: i&2 != 0,
+			BlockLen: rand.Intn(4096),
+			Variant:  uint8(i>>2) & Variant0124,
+		}
+		t.Run(fmt.Sprintf("RoundTrip: %d %+v", count, opts), func(t *testing.T) {
+			data := Marshal(nums, opts)
+			h, err := ReadHeader(data)
+			if err != nil {
+				t.Fatalf("failed to read header: %v", err)
+			}
+			if h.Count != count || h.Delta() != opts.Delta || h.Checksum() != opts.Checksum {
+				t.Fatalf("bad header %+v", h)
+			}
+
+			actual, err := Unmarshal(data)
+			if err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(nums, actual) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
+func TestRoundTripInt32(t *testing.T) {
+	for i := 0; i < 8; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenInt32(count)
+		opts := Options{
+			Delta:    i&1 != 0,
+			Checksum: i&2 != 0,
+			BlockLen: rand.Intn(4096),
+			Variant:  uint8(i>>2) & Variant0124,
+		}
+		t.Run(fmt.Sprintf("RoundTripInt32: %d %+v", count, opts), func(t *testing.T) {
+			data := MarshalInt32(nums, opts)
+			h, err := ReadHeader(data)
+			if err != nil {
+				t.Fatalf("failed to read header: %v", err)
+			}
+			if h.Count != count || !h.Zigzag() || h.Delta() != opts.Delta {
+				t.Fatalf("bad header %+v", h)
+			}
+
+			actual, err := UnmarshalInt32(data)
+			if err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(nums, actual) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			if _, err := Unmarshal(data); !errors.Is(err, ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+			if _, err := UnmarshalInt32(Marshal(nil, opts)); !errors.Is(err, ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestMarshalBlockLen(t *testing.T) {
+	nums := util.GenUint32(int(util.RandUint32()%1e4) + 1)
+	data := Marshal(nums, Options{BlockLen: int(^uint(0) >> 1)})
+	h, err := ReadHeader(data)
+	if err != nil {
+		t.Fatalf("failed to read header: %v", err)
+	}
+	if h.BlockLen != MaxBlockLen {
+		t.Fatalf("expected block len %d, got %d", MaxBlockLen, h.BlockLen)
+	}
+
+	actual, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(nums, actual) {
+		t.Fatalf("decoded wrong nums")
+	}
+}
+
+func TestMarshalUnknownVariant(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected unknown variant to panic")
+		}
+	}()
+	Marshal([]uint32{1, 2, 3}, Options{Variant: Variant0124 + 1})
+}
+
+func TestCorruption(t *testing.T) {
+	nums := util.GenUint32(int(util.RandUint32()%1e5) + 1)
+	data := Marshal(nums, Options{Checksum: true, BlockLen: 1024})
+
+	corrupt := append([]byte{}, data...)
+	corrupt[rand.Intn(len(corrupt)-headerLen-4)+headerLen+4] ^= 0xff
+	if _, err := Unmarshal(corrupt); err == nil {
+		t.Fatalf("expected corrupted data to fail")
+	}
+
+	corrupt = append([]byte{}, data...)
+	corrupt[8] ^= 0xff
+	if _, err := Unmarshal(corrupt); !errors.Is(err, ErrChecksum) {
+		t.Fatalf("expected checksum error, got %v", err)
+	}
+
+	if _, err := Unmarshal(data[:len(data)-1]); !errors.Is(err, ErrCorrupt) {
+		t.Fatalf("expected corrupt error, got %v", err)
+	}
+
+	if _, err := Unmarshal(data[1:]); !errors.Is(err, ErrInvalidMagic) {
+		t.Fatalf("expected magic error, got %v", err)
+	}
+}
+
+func TestCorruptionInt32(t *testing.T) {
+	nums := util.GenInt32(int(util.RandUint32()%1e5) + 1)
+	data := MarshalInt32(nums, Options{})
+
+	// Drop the last byte of the only block, keeping its length consistent
+	// so that the stream itself is found to be truncated.
+	corrupt := append([]byte{}, data[:len(data)-1]...)
+	binary.LittleEndian.PutUint32(corrupt[headerLen:], binary.LittleEndian.Uint32(corrupt[headerLen:])-1)
+	if _, err := UnmarshalInt32(corrupt); !errors.Is(err, ErrCorrupt) {
+		t.Fatalf("expected corrupt error, got %v", err)
+	}
+}
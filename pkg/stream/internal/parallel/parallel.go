@@ -0,0 +1,56 @@
+// Package parallel splits streams into the fixed size chunks that the
+// reader and writer packages process concurrently.
+package parallel
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ChunkLen is the count of integers processed by a worker at a time. It
+// does not depend on the count of workers, thus neither does the output of
+// the funcs using it.
+const ChunkLen = 1 << 16
+
+// Chunks returns the count of chunks holding count integers.
+func Chunks(count int) int {
+	return (count + ChunkLen - 1) / ChunkLen
+}
+
+// Bounds returns the positions of the first integer of the chunk c and of
+// the one following its last integer out of count integers.
+func Bounds(count, c int) (start, end int) {
+	start = c * ChunkLen
+	end = start + ChunkLen
+	if end > count {
+		end = count
+	}
+	return start, end
+}
+
+// ForEach calls fn for every one of chunks from up to workers goroutines
+// and waits for all of them to return. A workers value less than 1 selects
+// runtime.GOMAXPROCS.
+func ForEach(chunks, workers int, fn func(c int)) {
+	if chunks < 1 {
+		return
+	}
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > chunks {
+		workers = chunks
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for c := w; c < chunks; c += workers {
+				fn(c)
+			}
+		}(w)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,286 @@
+// Package postings provides set operations over posting lists, i.e.
+// sorted lists of unique integers stored as differentially coded Stream
+// VByte streams such as the ones produced by writer.WriteAllDelta. The
+// lists are decoded a block at a time and, when a skip index is attached
+// to a list, blocks whose last integer is below the one being looked for
+// are skipped without being decoded at all.
+//
+// Every operation comes in two flavours: one returning the result as a
+// []uint32 and one, suffixed with Encoded, re-encoding the result directly
+// into a new posting list without materializing it first.
+package postings
+
+import (
+	"container/heap"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/stream/reader"
+)
+
+// List is a posting list encoded as a differentially coded Stream VByte
+// stream.
+type List struct {
+	// Count is the count of integers in the list.
+	Count int
+	// Stream holds the encoded integers.
+	Stream []byte
+	// Prev is the base value the first integer was differentially coded
+	// against.
+	Prev uint32
+	// Index is an optional skip index over Stream, e.g. as produced by
+	// writer.WriteAllDeltaIndexed.
+	Index *shared.Index
+}
+
+// Iterator returns a new reader.DeltaIterator over the integers of l.
+func (l List) Iterator() *reader.DeltaIterator {
+	return reader.NewDeltaIterator(l.Count, l.Stream, l.Prev, l.Index)
+}
+
+// Intersect returns the integers present in both a and b.
+func Intersect(a, b List) []uint32 {
+	return IntersectAll(a, b)
+}
+
+// IntersectEncoded works similarly to Intersect except that the result is
+// returned as a new posting list.
+func IntersectEncoded(a, b List) List {
+	return IntersectAllEncoded(a, b)
+}
+
+// IntersectAll returns the integers present in every one of lists.
+func IntersectAll(lists ...List) []uint32 {
+	var s sliceSink
+	intersect(&s, lists)
+	return s.vals
+}
+
+// IntersectAllEncoded works similarly to IntersectAll except that the
+// result is returned as a new posting list.
+func IntersectAllEncoded(lists ...List) List {
+	var s encodeSink
+	intersect(&s, lists)
+	return s.list()
+}
+
+// Union returns the integers present in either a or b.
+func Union(a, b List) []uint32 {
+	return UnionAll(a, b)
+}
+
+// UnionEncoded works similarly to Union except that the result is returned
+// as a new posting list.
+func UnionEncoded(a, b List) List {
+	return UnionAllEncoded(a, b)
+}
+
+// UnionAll returns the integers present in any one of lists.
+func UnionAll(lists ...List) []uint32 {
+	var s sliceSink
+	union(&s, lists)
+	return s.vals
+}
+
+// UnionAllEncoded works similarly to UnionAll except that the result is
+// returned as a new posting list.
+func UnionAllEncoded(lists ...List) List {
+	var s encodeSink
+	union(&s, lists)
+	return s.list()
+}
+
+// Difference returns the integers present in a but not in b.
+func Difference(a, b List) []uint32 {
+	return DifferenceAll(a, b)
+}
+
+// DifferenceEncoded works similarly to Difference except that the result
+// is returned as a new posting list.
+func DifferenceEncoded(a, b List) List {
+	return DifferenceAllEncoded(a, b)
+}
+
+// DifferenceAll returns the integers present in a but in none of others.
+func DifferenceAll(a List, others ...List) []uint32 {
+	var s sliceSink
+	difference(&s, a, others)
+	return s.vals
+}
+
+// DifferenceAllEncoded works similarly to DifferenceAll except that the
+// result is returned as a new posting list.
+func DifferenceAllEncoded(a List, others ...List) List {
+	var s encodeSink
+	difference(&s, a, others)
+	return s.list()
+}
+
+// intersect leapfrogs over the iterators of lists: every iterator is
+// moved to the largest integer seen so far until all of them agree on it.
+func intersect(s sink, lists []List) {
+	if len(lists) == 0 {
+		return
+	}
+
+	its := make([]*reader.DeltaIterator, len(lists))
+	for i, l := range lists {
+		its[i] = l.Iterator()
+		if !its[i].Next() {
+			return
+		}
+	}
+
+	x := its[0].Value()
+	for {
+		agreed := true
+		for _, it := range its {
+			if !it.SeekGE(x) {
+				return
+			}
+			if v := it.Value(); v != x {
+				x, agreed = v, false
+				break
+			}
+		}
+
+		if agreed {
+			s.add(x)
+			if !its[0].Next() {
+				return
+			}
+			x = its[0].Value()
+		}
+	}
+}
+
+// union merges the iterators of lists using a min-heap, dropping the
+// integers present in more than one of them.
+func union(s sink, lists []List) {
+	h := make(iteratorHeap, 0, len(lists))
+	for _, l := range lists {
+		if it := l.Iterator(); it.Next() {
+			h = append(h, it)
+		}
+	}
+	heap.Init(&h)
+
+	first := true
+	var last uint32
+	for len(h) > 0 {
+		it := h[0]
+		if x := it.Value(); first || x != last {
+			s.add(x)
+			first, last = false, x
+		}
+
+		if it.Next() {
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+}
+
+// difference walks over a and seeks every iterator of others to each of
+// its integers, skipping the integers found in any of them.
+func difference(s sink, a List, others []List) {
+	its := make([]*reader.DeltaIterator, 0, len(others))
+	for _, l := range others {
+		its = append(its, l.Iterator())
+	}
+
+	for it := a.Iterator(); it.Next(); {
+		x := it.Value()
+		found := false
+		for i := 0; i < len(its) && !found; i++ {
+			if !its[i].SeekGE(x) {
+				its = append(its[:i], its[i+1:]...)
+				i--
+				continue
+			}
+			found = its[i].Value() == x
+		}
+
+		if !found {
+			s.add(x)
+		}
+	}
+}
+
+type iteratorHeap []*reader.DeltaIterator
+
+func (h iteratorHeap) Len() int            { return len(h) }
+func (h iteratorHeap) Less(i, j int) bool  { return h[i].Value() < h[j].Value() }
+func (h iteratorHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *iteratorHeap) Push(x interface{}) { *h = append(*h, x.(*reader.DeltaIterator)) }
+
+func (h *iteratorHeap) Pop() interface{} {
+	old := *h
+	it := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return it
+}
+
+// sink receives the sorted integers produced by a set operation.
+type sink interface {
+	add(x uint32)
+}
+
+// sliceSink collects the integers into a slice.
+type sliceSink struct {
+	vals []uint32
+}
+
+func (s *sliceSink) add(x uint32) {
+	s.vals = append(s.vals, x)
+}
+
+// encodeSink differentially codes the integers 8 at a time as they come
+// in, keeping the control bytes and data bytes apart until the list is
+// complete.
+type encodeSink struct {
+	ctrls []byte
+	data  []byte
+	batch [8]uint32
+	n     int
+	count int
+	prev  uint32
+}
+
+func (s *encodeSink) add(x uint32) {
+	s.batch[s.n] = x
+	s.n++
+	s.count++
+	if s.n < len(s.batch) {
+		return
+	}
+
+	var out [8 * encode.MaxBytesPerNum]byte
+	ctrl := encode.Put8uint32Delta(s.batch[:], out[:], s.prev)
+	s.ctrls = append(s.ctrls, uint8(ctrl), uint8(ctrl>>8))
+	s.data = append(s.data, out[:shared.ControlByteToSizeTwo(ctrl)]...)
+	s.prev = s.batch[7]
+	s.n = 0
+}
+
+// list encodes the pending integers and returns the resulting list.
+func (s *encodeSink) list() List {
+	var out [4 * encode.MaxBytesPerNum]byte
+	for i := 0; i < s.n; i += 4 {
+		n := s.n - i
+		if n > 4 {
+			n = 4
+		}
+
+		ctrl := encode.PutUint32DeltaScalar(s.batch[i:], out[:], n, s.prev)
+		s.ctrls = append(s.ctrls, ctrl)
+		s.data = append(s.data, out[:shared.ControlByteToSize(ctrl)-(4-n)]...)
+		s.prev = s.batch[i+n-1]
+	}
+
+	return List{
+		Count:  s.count,
+		Stream: append(s.ctrls, s.data...),
+	}
+}
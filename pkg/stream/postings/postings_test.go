@@ -0,0 +1,122 @@
+package postings
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/stream/reader"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/stream/writer"
+)
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+}
+
+// genList generates a posting list of up to count unique integers below
+// limit, attaching a skip index to it half of the time.
+func genList(count int, limit uint32) ([]uint32, List) {
+	seen := make(map[uint32]bool, count)
+	for i := 0; i < count; i++ {
+		seen[rand.Uint32()%limit] = true
+	}
+
+	nums := make([]uint32, 0, len(seen))
+	for num := range seen {
+		nums = append(nums, num)
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+
+	l := List{Count: len(nums)}
+	if rand.Intn(2) == 0 {
+		l.Stream, l.Index = writer.WriteAllDeltaIndexed(nums, 0, rand.Intn(512))
+	} else {
+		l.Stream = writer.WriteAllDelta(nums, 0)
+	}
+	return nums, l
+}
+
+// naive computes the expected result of a set operation by counting in
+// how many of the lists every integer is present.
+func naive(lists [][]uint32, keep func(counts []int) bool) []uint32 {
+	counts := make(map[uint32][]int)
+	for i, nums := range lists {
+		for _, num := range nums {
+			if counts[num] == nil {
+				counts[num] = make([]int, len(lists))
+			}
+			counts[num][i]++
+		}
+	}
+
+	out := make([]uint32, 0)
+	for num, c := range counts {
+		if keep(c) {
+			out = append(out, num)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func decodeList(l List) []uint32 {
+	out := make([]uint32, l.Count)
+	reader.ReadAllDelta(l.Count, l.Stream, out, l.Prev)
+	return out
+}
+
+func TestSetOperations(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		k := rand.Intn(4) + 1
+		limit := uint32(rand.Intn(1e6)) + 1
+		nums := make([][]uint32, k)
+		lists := make([]List, k)
+		for j := range lists {
+			nums[j], lists[j] = genList(rand.Intn(1e5), limit)
+		}
+
+		t.Run(fmt.Sprintf("%d lists below %d", k, limit), func(t *testing.T) {
+			expected := naive(nums, func(c []int) bool {
+				for _, n := range c {
+					if n == 0 {
+						return false
+					}
+				}
+				return true
+			})
+			check(t, "intersect", expected, IntersectAll(lists...), IntersectAllEncoded(lists...))
+
+			expected = naive(nums, func(c []int) bool { return true })
+			check(t, "union", expected, UnionAll(lists...), UnionAllEncoded(lists...))
+
+			expected = naive(nums, func(c []int) bool {
+				for _, n := range c[1:] {
+					if n != 0 {
+						return false
+					}
+				}
+				return c[0] != 0
+			})
+			check(t, "difference", expected, DifferenceAll(lists[0], lists[1:]...), DifferenceAllEncoded(lists[0], lists[1:]...))
+
+			if k == 2 {
+				check(t, "intersect", IntersectAll(lists...), Intersect(lists[0], lists[1]), IntersectEncoded(lists[0], lists[1]))
+				check(t, "union", UnionAll(lists...), Union(lists[0], lists[1]), UnionEncoded(lists[0], lists[1]))
+				check(t, "difference", DifferenceAll(lists[0], lists[1]), Difference(lists[0], lists[1]), DifferenceEncoded(lists[0], lists[1]))
+			}
+		})
+	}
+}
+
+func check(t *testing.T, op string, expected, actual []uint32, encoded List) {
+	t.Helper()
+	if len(expected) != len(actual) || (len(actual) > 0 && !reflect.DeepEqual(expected, actual)) {
+		t.Fatalf("%s: expected %d integers, got %d", op, len(expected), len(actual))
+	}
+	if decoded := decodeList(encoded); len(expected) != len(decoded) || (len(decoded) > 0 && !reflect.DeepEqual(expected, decoded)) {
+		t.Fatalf("%s: encoded list differs", op)
+	}
+}
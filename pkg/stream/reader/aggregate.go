@@ -0,0 +1,223 @@
+package reader
+
+import (
+	"math"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// Sum returns the sum of the count integers of stream. With special
+// hardware instructions available, the integers are decoded 8 at a time
+// and added up in-register without ever being written out to memory.
+func Sum(count int, stream []byte) uint64 {
+	ctrls, data, bulk := splitBulk(count, stream)
+	var sum uint64
+	offset := 0
+	if bulk > 0 {
+		sum, offset = decode.SumUint32Fast(ctrls[:bulk], data)
+	}
+
+	reduceScalar(count-4*bulk, ctrls[bulk:], data[offset:], 0, false, func(nums []uint32) {
+		for _, num := range nums {
+			sum += uint64(num)
+		}
+	})
+	return sum
+}
+
+// SumDelta returns the sum of the count differentially coded integers of
+// stream, i.e. the sum of the prefix sums of the diffs. With special
+// hardware instructions available, the original values are reconstructed
+// and added up in-register without ever being written out to memory.
+func SumDelta(count int, stream []byte, prev uint32) uint64 {
+	ctrls, data, bulk := splitBulk(count, stream)
+	var sum uint64
+	offset := 0
+	if bulk > 0 {
+		sum, prev, offset = decode.SumUint32DeltaFast(ctrls[:bulk], data, prev)
+	}
+
+	reduceScalar(count-4*bulk, ctrls[bulk:], data[offset:], prev, true, func(nums []uint32) {
+		for _, num := range nums {
+			sum += uint64(num)
+		}
+	})
+	return sum
+}
+
+// Min returns the smallest of the count integers of stream, or 0 if the
+// stream is empty. It decodes the integers the same way as Sum.
+func Min(count int, stream []byte) uint32 {
+	if count == 0 {
+		return 0
+	}
+
+	ctrls, data, bulk := splitBulk(count, stream)
+	min := uint32(math.MaxUint32)
+	offset := 0
+	if bulk > 0 {
+		min, offset = decode.MinUint32Fast(ctrls[:bulk], data)
+	}
+
+	reduceScalar(count-4*bulk, ctrls[bulk:], data[offset:], 0, false, func(nums []uint32) {
+		min = minOf(min, nums)
+	})
+	return min
+}
+
+// MinDelta returns the smallest of the count differentially coded integers
+// of stream, or 0 if the stream is empty. It decodes the integers the same
+// way as SumDelta.
+func MinDelta(count int, stream []byte, prev uint32) uint32 {
+	if count == 0 {
+		return 0
+	}
+
+	ctrls, data, bulk := splitBulk(count, stream)
+	min := uint32(math.MaxUint32)
+	offset := 0
+	if bulk > 0 {
+		min, prev, offset = decode.MinUint32DeltaFast(ctrls[:bulk], data, prev)
+	}
+
+	reduceScalar(count-4*bulk, ctrls[bulk:], data[offset:], prev, true, func(nums []uint32) {
+		min = minOf(min, nums)
+	})
+	return min
+}
+
+// Max returns the largest of the count integers of stream, or 0 if the
+// stream is empty. It decodes the integers the same way as Sum.
+func Max(count int, stream []byte) uint32 {
+	ctrls, data, bulk := splitBulk(count, stream)
+	var max uint32
+	offset := 0
+	if bulk > 0 {
+		max, offset = decode.MaxUint32Fast(ctrls[:bulk], data)
+	}
+
+	reduceScalar(count-4*bulk, ctrls[bulk:], data[offset:], 0, false, func(nums []uint32) {
+		max = maxOf(max, nums)
+	})
+	return max
+}
+
+// MaxDelta returns the largest of the count differentially coded integers
+// of stream, or 0 if the stream is empty. It decodes the integers the same
+// way as SumDelta.
+func MaxDelta(count int, stream []byte, prev uint32) uint32 {
+	ctrls, data, bulk := splitBulk(count, stream)
+	var max uint32
+	offset := 0
+	if bulk > 0 {
+		max, prev, offset = decode.MaxUint32DeltaFast(ctrls[:bulk], data, prev)
+	}
+
+	reduceScalar(count-4*bulk, ctrls[bulk:], data[offset:], prev, true, func(nums []uint32) {
+		max = maxOf(max, nums)
+	})
+	return max
+}
+
+// Xor returns the bitwise xor of the count integers of stream. It decodes
+// the integers the same way as Sum.
+func Xor(count int, stream []byte) uint32 {
+	ctrls, data, bulk := splitBulk(count, stream)
+	var xor uint32
+	offset := 0
+	if bulk > 0 {
+		xor, offset = decode.XorUint32Fast(ctrls[:bulk], data)
+	}
+
+	reduceScalar(count-4*bulk, ctrls[bulk:], data[offset:], 0, false, func(nums []uint32) {
+		for _, num := range nums {
+			xor ^= num
+		}
+	})
+	return xor
+}
+
+// XorDelta returns the bitwise xor of the count differentially coded
+// integers of stream. It decodes the integers the same way as SumDelta.
+func XorDelta(count int, stream []byte, prev uint32) uint32 {
+	ctrls, data, bulk := splitBulk(count, stream)
+	var xor uint32
+	offset := 0
+	if bulk > 0 {
+		xor, prev, offset = decode.XorUint32DeltaFast(ctrls[:bulk], data, prev)
+	}
+
+	reduceScalar(count-4*bulk, ctrls[bulk:], data[offset:], prev, true, func(nums []uint32) {
+		for _, num := range nums {
+			xor ^= num
+		}
+	})
+	return xor
+}
+
+// splitBulk splits stream into its control bytes and data bytes and
+// returns the number of leading control bytes, an even number, that can be
+// handed to the reduction kernels. Given two control bytes, the kernels
+// load 16 bytes from the data of each of them, so the data of the control
+// bytes following the first of a pair must amount to at least 16 bytes for
+// the loads to stay within the data. That is found by walking back from
+// the last control byte, which takes a handful of steps at most.
+func splitBulk(count int, stream []byte) (ctrls, data []byte, bulk int) {
+	ctrls = stream[:(count+3)/4]
+	data = stream[len(ctrls):]
+	if decode.GetMode() != shared.Fast {
+		return ctrls, data, 0
+	}
+
+	// tail is the count of data bytes of the control bytes from i onwards.
+	tail := 0
+	for i := len(ctrls) - 1; i > 0; i-- {
+		if rem := count - 4*i; rem < 4 {
+			tail += partialSize(shared.PerNumLenTable, ctrls[i], rem)
+		} else {
+			tail += shared.ControlByteToSize(ctrls[i])
+		}
+		if tail >= 16 {
+			return ctrls, data, (i + 1) &^ 1
+		}
+	}
+	return ctrls, data, 0
+}
+
+// reduceScalar decodes the count integers described by ctrls from data a
+// group of 4 at a time, handing every group over to fn.
+func reduceScalar(count int, ctrls, data []byte, prev uint32, delta bool, fn func(nums []uint32)) {
+	var (
+		group  [4]uint32
+		offset = 0
+	)
+	for pos := 0; pos < count; pos += 4 {
+		n := groupLen(count, pos)
+		if delta {
+			offset += decode.GetUint32DeltaScalar(data[offset:], group[:], ctrls[pos/4], n, prev)
+			prev = group[n-1]
+		} else {
+			offset += decode.GetUint32Scalar(data[offset:], group[:], ctrls[pos/4], n)
+		}
+		fn(group[:n])
+	}
+}
+
+func minOf(min uint32, nums []uint32) uint32 {
+	for _, num := range nums {
+		if num < min {
+			min = num
+		}
+	}
+	return min
+}
+
+func maxOf(max uint32, nums []uint32) uint32 {
+	for _, num := range nums {
+		if num > max {
+			max = num
+		}
+	}
+	return max
+}
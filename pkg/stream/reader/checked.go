@@ -0,0 +1,123 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// ReadAllChecked will read the entire input stream into out according to the
+// Stream VByte format. Unlike ReadAll, it is safe to use on untrusted input:
+// the control bytes are first validated against the length of stream and out.
+// It will select the best implementation depending on the presence of special
+// hardware instructions.
+//
+// If the stream is truncated or out is too small, the largest prefix of
+// whole groups that can be decoded is still written to out and a *DecodeError
+// wrapping ErrTruncated or ErrShortOutput is returned. Returns the number of
+// integers written to out.
+func ReadAllChecked(count int, stream []byte, out []uint32) (int, error) {
+	ctrls, data, valid, err := validate(count, stream, len(out), shared.VariantStandard)
+	readAllTier(decode.GetTier(), valid, ctrls, data, out)
+	return valid, err
+}
+
+// ReadAllDeltaChecked will read the entire input stream into out according to
+// the Stream VByte format and reconstruct the original non differentially
+// encoded values. It validates the stream the same way as ReadAllChecked.
+// Returns the number of integers written to out.
+func ReadAllDeltaChecked(count int, stream []byte, out []uint32, prev uint32) (int, error) {
+	ctrls, data, valid, err := validate(count, stream, len(out), shared.VariantStandard)
+	readAllDeltaTier(decode.GetTier(), valid, ctrls, data, out, prev)
+	return valid, err
+}
+
+// ReadAllVariantChecked works similarly to ReadAllChecked except that the
+// stream is read according to the provided variant of Stream VByte.
+// Returns the number of integers written to out.
+func ReadAllVariantChecked(count int, stream []byte, out []uint32, v shared.Variant) (int, error) {
+	if v != shared.Variant0124 {
+		return ReadAllChecked(count, stream, out)
+	}
+
+	ctrls, data, valid, err := validate(count, stream, len(out), v)
+	readAll0124(valid, ctrls, data, out)
+	return valid, err
+}
+
+// ReadAllDeltaVariantChecked works similarly to ReadAllDeltaChecked except
+// that the stream is read according to the provided variant of Stream
+// VByte. Returns the number of integers written to out.
+func ReadAllDeltaVariantChecked(count int, stream []byte, out []uint32, prev uint32, v shared.Variant) (int, error) {
+	if v != shared.Variant0124 {
+		return ReadAllDeltaChecked(count, stream, out, prev)
+	}
+
+	ctrls, data, valid, err := validate(count, stream, len(out), v)
+	readAllDelta0124(valid, ctrls, data, out, prev)
+	return valid, err
+}
+
+// ReadAllInt32Checked works similarly to ReadAllInt32 except that it
+// validates the stream the same way as ReadAllChecked. Returns the number
+// of integers written to out.
+func ReadAllInt32Checked(count int, stream []byte, out []int32) (int, error) {
+	ctrls, data, valid, err := validate(count, stream, len(out), shared.VariantStandard)
+	readAllInt32(valid, ctrls, data, out)
+	return valid, err
+}
+
+// validate walks the control bytes of stream and makes sure that every group
+// they describe is backed by data bytes and fits into an output of outLen
+// integers. It returns the control bytes and data bytes of the valid prefix
+// along with the count of integers in that prefix.
+func validate(count int, stream []byte, outLen int, v shared.Variant) (ctrls, data []byte, valid int, err error) {
+	if count < 0 {
+		return nil, nil, 0, &DecodeError{Err: ErrInvalidCount}
+	}
+
+	// Checked before computing the control length, which would overflow for
+	// a hostile count, without multiplying the length of stream either.
+	if count > 0 && (count-1)/4 >= len(stream) {
+		return nil, nil, 0, &DecodeError{Err: ErrTruncated, Offset: len(stream)}
+	}
+
+	ctrlLen := (count + 3) / 4
+
+	ctrls, data = stream[:ctrlLen], stream[ctrlLen:]
+	groups := ctrlLen
+	if outLen < count {
+		groups = outLen / 4
+		err = ErrShortOutput
+	}
+
+	lens := v.PerControlLenTable()
+	dataPos := 0
+	for i := 0; i < groups; i++ {
+		size := int(lens[ctrls[i]])
+		if rem := count - i*4; rem < 4 {
+			size = partialSize(v.PerNumLenTable(), ctrls[i], rem)
+		}
+
+		if dataPos+size > len(data) {
+			return ctrls[:i], data, i * 4, &DecodeError{Err: ErrTruncated, Offset: ctrlLen + dataPos}
+		}
+		dataPos += size
+	}
+
+	if err != nil {
+		return ctrls[:groups], data, groups * 4, &DecodeError{Err: err, Offset: ctrlLen + dataPos}
+	}
+
+	return ctrls, data, count, nil
+}
+
+// partialSize returns the number of data bytes used by the first count
+// integers described by ctrl.
+func partialSize(lens *[256][4]uint8, ctrl uint8, count int) int {
+	sizes := lens[ctrl]
+	total := 0
+	for i := 0; i < count; i++ {
+		total += int(sizes[i])
+	}
+	return total
+}
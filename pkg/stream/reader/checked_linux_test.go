@@ -0,0 +1,152 @@
+package reader
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"syscall"
+	"testing"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/stream/writer"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/util"
+)
+
+// guarded returns a copy of b that ends right before a PROT_NONE page, so
+// that reading past its end faults.
+func guarded(t *testing.T, b []byte) []byte {
+	pageSize := os.Getpagesize()
+	pages := (len(b)+pageSize-1)/pageSize + 1
+	mem, err := syscall.Mmap(-1, 0, pages*pageSize, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("failed to map memory: %v", err)
+	}
+	t.Cleanup(func() { _ = syscall.Munmap(mem) })
+
+	guard := len(mem) - pageSize
+	if err := syscall.Mprotect(mem[guard:], syscall.PROT_NONE); err != nil {
+		t.Fatalf("failed to protect guard page: %v", err)
+	}
+	guarded := mem[guard-len(b) : guard : guard]
+	copy(guarded, b)
+	return guarded
+}
+
+func TestReadAllGuarded(t *testing.T) {
+	defer decode.SetTier(decode.SupportedTier())
+	defer shared.SetMode(shared.GetMode())
+	shared.SetMode(shared.Fast)
+
+	counts := []int{int(util.RandUint32() % 1e4)}
+	for count := 0; count <= 64; count++ {
+		counts = append(counts, count)
+	}
+
+	for tier := shared.TierScalar; tier <= decode.SupportedTier(); tier++ {
+		if err := decode.SetTier(tier); err != nil {
+			t.Fatal(err)
+		}
+
+		for i, count := range counts {
+			nums := util.GenUint32(count)
+			for j := range nums {
+				switch {
+				case i%3 == 0:
+					nums[j] >>= rand.Intn(32)
+				case i%3 == 1 && j >= count-16:
+					// Large groups followed by small ones are the worst
+					// case for reading past the end of the data.
+					nums[j] = 0
+				}
+			}
+			prev := util.RandUint32()
+			t.Run(fmt.Sprintf("ReadAll %v: %d", tier, count), func(t *testing.T) {
+				stream := guarded(t, writer.WriteAll(nums))
+				out := make([]uint32, count)
+				if n, err := ReadAllChecked(count, stream, out); n != count || err != nil {
+					t.Fatalf("failed to read: %d %v", n, err)
+				}
+				if !reflect.DeepEqual(nums, out) {
+					t.Fatalf("decoded wrong nums")
+				}
+
+				out = make([]uint32, count)
+				ReadAllFast(count, stream, out)
+				if !reflect.DeepEqual(nums, out) {
+					t.Fatalf("decoded wrong nums")
+				}
+
+				stream = guarded(t, writer.WriteAllDelta(nums, prev))
+				out = make([]uint32, count)
+				if n, err := ReadAllDeltaChecked(count, stream, out, prev); n != count || err != nil {
+					t.Fatalf("failed to read delta: %d %v", n, err)
+				}
+				if !reflect.DeepEqual(nums, out) {
+					t.Fatalf("decoded wrong delta nums")
+				}
+
+				out = make([]uint32, count)
+				ReadAllDeltaFast(count, stream, out, prev)
+				if !reflect.DeepEqual(nums, out) {
+					t.Fatalf("decoded wrong delta nums")
+				}
+
+				nums32 := make([]int32, count)
+				for j, num := range nums {
+					nums32[j] = int32(num)
+				}
+				stream = guarded(t, writer.WriteAllInt32(nums32))
+				out32 := make([]int32, count)
+				if n, err := ReadAllInt32Checked(count, stream, out32); n != count || err != nil {
+					t.Fatalf("failed to read int32: %d %v", n, err)
+				}
+				if !reflect.DeepEqual(nums32, out32) {
+					t.Fatalf("decoded wrong int32 nums")
+				}
+			})
+		}
+	}
+}
+
+func TestAggregateGuarded(t *testing.T) {
+	defer shared.SetMode(shared.GetMode())
+	shared.SetMode(shared.Fast)
+
+	for count := 0; count <= 64; count++ {
+		nums := util.GenUint32(count)
+		for j := count - 16; j < count; j++ {
+			if j >= 0 {
+				nums[j] = 0
+			}
+		}
+		prev := util.RandUint32()
+		t.Run(fmt.Sprintf("Aggregate: %d", count), func(t *testing.T) {
+			var (
+				sum uint64
+				xor uint32
+			)
+			for _, num := range nums {
+				sum += uint64(num)
+				xor ^= num
+			}
+
+			stream := guarded(t, writer.WriteAll(nums))
+			if actual := Sum(count, stream); actual != sum {
+				t.Fatalf("expected sum %d, got %d", sum, actual)
+			}
+			if actual := Xor(count, stream); actual != xor {
+				t.Fatalf("expected xor %d, got %d", xor, actual)
+			}
+
+			stream = guarded(t, writer.WriteAllDelta(nums, prev))
+			if actual := SumDelta(count, stream, prev); actual != sum {
+				t.Fatalf("expected delta sum %d, got %d", sum, actual)
+			}
+			if actual := XorDelta(count, stream, prev); actual != xor {
+				t.Fatalf("expected delta xor %d, got %d", xor, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package reader
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// Decoder reads integers from an io.Reader holding the framed Stream VByte
+// format described in the shared package, e.g. as produced by
+// writer.Encoder. Blocks are pulled from the underlying reader one at a time
+// and decoded using the fastest implementation available, so at most one
+// block of input is ever buffered. A Decoder is not safe for concurrent use.
+type Decoder struct {
+	r       io.Reader
+	br      io.ByteReader
+	delta   bool
+	prev    uint32
+	started bool
+	block   []byte
+	vals    []uint32
+	pos     int
+	err     error
+}
+
+// NewDecoder returns a Decoder reading from r. If r does not implement
+// io.ByteReader, frame headers are read from it one byte at a time so that
+// the Decoder never consumes more input than it decodes.
+func NewDecoder(r io.Reader) *Decoder {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = &byteReader{r: r}
+	}
+	return &Decoder{r: r, br: br}
+}
+
+// Read decodes up to len(dst) integers into dst and returns the count of
+// integers decoded. It returns io.EOF once the end of the stream has been
+// reached and io.ErrUnexpectedEOF if the stream ends in the middle of a
+// block.
+func (d *Decoder) Read(dst []uint32) (int, error) {
+	if len(dst) == 0 {
+		return 0, nil
+	}
+
+	for d.pos == len(d.vals) {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.err = d.readBlock()
+	}
+
+	n := copy(dst, d.vals[d.pos:])
+	d.pos += n
+	return n, nil
+}
+
+// readBlock reads and decodes the next block from the underlying reader,
+// reading the stream header first if needed.
+func (d *Decoder) readBlock() error {
+	if !d.started {
+		flags, err := d.br.ReadByte()
+		if err != nil {
+			return err
+		}
+		if flags&^shared.FrameFlagDelta != 0 {
+			return fmt.Errorf("%w: unknown flags %#02x", ErrCorruptFrame, flags)
+		}
+
+		prev, err := binary.ReadUvarint(d.br)
+		if err != nil {
+			return unexpected(err)
+		}
+		if prev > math.MaxUint32 {
+			return fmt.Errorf("%w: invalid prev %d", ErrCorruptFrame, prev)
+		}
+
+		d.delta = flags&shared.FrameFlagDelta != 0
+		d.prev = uint32(prev)
+		d.started = true
+	}
+
+	count, err := binary.ReadUvarint(d.br)
+	if err != nil {
+		return err
+	}
+	if count == 0 || count > shared.MaxFrameBlockLen {
+		return fmt.Errorf("%w: invalid block count %d", ErrCorruptFrame, count)
+	}
+
+	size, err := binary.ReadUvarint(d.br)
+	if err != nil {
+		return unexpected(err)
+	}
+	if maxSize := (count+3)/4 + 4*count; size > maxSize {
+		return fmt.Errorf("%w: invalid block length %d", ErrCorruptFrame, size)
+	}
+
+	if uint64(cap(d.block)) < size {
+		d.block = make([]byte, size)
+	}
+	d.block = d.block[:size]
+	if _, err := io.ReadFull(d.r, d.block); err != nil {
+		return unexpected(err)
+	}
+
+	// A block must hold exactly the encoding of its integers, trailing
+	// bytes included.
+	ctrlLen := (int(count) + 3) / 4
+	if int(size) < ctrlLen || int(size) != ctrlLen+DataLen(int(count), d.block[:ctrlLen]) {
+		return fmt.Errorf("%w: invalid block length %d", ErrCorruptFrame, size)
+	}
+
+	if uint64(cap(d.vals)) < count {
+		d.vals = make([]uint32, count)
+	}
+	d.vals, d.pos = d.vals[:count], 0
+
+	if d.delta {
+		_, err = ReadAllDeltaChecked(int(count), d.block, d.vals, d.prev)
+		d.prev = d.vals[count-1]
+	} else {
+		_, err = ReadAllChecked(int(count), d.block, d.vals)
+	}
+
+	if err != nil {
+		d.vals = d.vals[:0]
+		return fmt.Errorf("%w: %v", ErrCorruptFrame, err)
+	}
+	return nil
+}
+
+// unexpected converts an io.EOF encountered in the middle of a frame into
+// io.ErrUnexpectedEOF.
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// byteReader reads single bytes from an io.Reader without buffering.
+type byteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+func (b *byteReader) ReadByte() (byte, error) {
+	_, err := io.ReadFull(b.r, b.buf[:])
+	return b.buf[0], err
+}
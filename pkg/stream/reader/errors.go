@@ -0,0 +1,45 @@
+package reader
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrTruncated indicates that the stream ended before all the data
+	// described by its control bytes could be read.
+	ErrTruncated = errors.New("streamvbyte: truncated stream")
+
+	// ErrShortOutput indicates that the output slice cannot hold the
+	// number of integers described by the stream.
+	ErrShortOutput = errors.New("streamvbyte: output too short")
+
+	// ErrInvalidCount indicates that a negative count was provided.
+	ErrInvalidCount = errors.New("streamvbyte: invalid count")
+
+	// ErrCorruptFrame indicates that a framed stream has an invalid header
+	// or block.
+	ErrCorruptFrame = errors.New("streamvbyte: corrupt frame")
+
+	// ErrCorruptTrailer indicates that a stream in the trailer format has
+	// an invalid footer or does not match the length it describes.
+	ErrCorruptTrailer = errors.New("streamvbyte: corrupt trailer")
+)
+
+// DecodeError describes where in the stream decoding stopped. Err is one
+// of the sentinel errors of this package and can be matched using
+// errors.Is.
+type DecodeError struct {
+	Err error
+	// Offset is the position in the stream of the first byte belonging
+	// to the group of integers that could not be decoded.
+	Offset int
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s at byte offset %d", e.Err, e.Offset)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
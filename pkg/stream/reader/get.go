@@ -0,0 +1,168 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// Get returns the integer at position i of the stream holding count
+// integers. The offset of its data bytes is computed by summing up the
+// lengths described by the control bytes preceding it, thus only the
+// group of 4 integers holding it is decoded.
+//
+// Note: It is your responsibility to ensure that i is less than count.
+func Get(count int, stream []byte, i int) uint32 {
+	var (
+		ctrls  = stream[:(count+3)/4]
+		data   = stream[len(ctrls):]
+		pos    = i &^ 3
+		offset = decode.ControlLen(ctrls[:pos/4])
+		group  [4]uint32
+	)
+
+	decode.GetUint32Scalar(data[offset:], group[:], ctrls[pos/4], groupLen(count, pos))
+	return group[i-pos]
+}
+
+// GetRange decodes the integers at positions i up to, but not including, j
+// of the stream holding count integers into out. Like Get, it seeks to the
+// group holding i by summing up the lengths described by the control bytes
+// preceding it.
+//
+// Note: It is your responsibility to ensure that i <= j <= count and that
+// out can hold j-i integers.
+func GetRange(count int, stream []byte, i, j int, out []uint32) {
+	if i == j {
+		return
+	}
+
+	var (
+		ctrls  = stream[:(count+3)/4]
+		data   = stream[len(ctrls):]
+		pos    = i &^ 3
+		offset = decode.ControlLen(ctrls[:pos/4])
+	)
+	readFrom(count, ctrls, data, pos, offset, 0, false, i, out[:j-i])
+}
+
+// GetDelta returns the integer at position i of the differentially coded
+// stream holding count integers. Since reconstructing it requires every
+// integer preceding it, the stream is decoded from the closest checkpoint
+// of idx, or from its start if idx is nil.
+//
+// Note: It is your responsibility to ensure that i is less than count.
+func GetDelta(count int, stream []byte, prev uint32, idx *shared.Index, i int) uint32 {
+	var out [1]uint32
+	GetRangeDelta(count, stream, prev, idx, i, i+1, out[:])
+	return out[0]
+}
+
+// GetRangeDelta decodes the integers at positions i up to, but not
+// including, j of the differentially coded stream holding count integers
+// into out. The stream is decoded from the closest checkpoint of idx, or
+// from its start if idx is nil.
+//
+// Note: It is your responsibility to ensure that i <= j <= count and that
+// out can hold j-i integers.
+func GetRangeDelta(count int, stream []byte, prev uint32, idx *shared.Index, i, j int, out []uint32) {
+	if i == j {
+		return
+	}
+
+	var (
+		ctrls  = stream[:(count+3)/4]
+		data   = stream[len(ctrls):]
+		pos    = 0
+		offset = 0
+	)
+	if idx != nil {
+		pos, offset, prev = idx.Lookup(i)
+	}
+
+	pos, offset, prev = seek(ctrls, data, pos, offset, prev, true, i)
+	readFrom(count, ctrls, data, pos, offset, prev, true, i, out[:j-i])
+}
+
+// seek advances from the group at position pos, whose data starts at
+// offset, to the group holding the integer at position start. Differentially
+// coded integers in between have to be decoded to recover prev, while the
+// others can be skipped over by summing up the lengths described by their
+// control bytes.
+func seek(ctrls, data []byte, pos, offset int, prev uint32, delta bool, start int) (int, int, uint32) {
+	if !delta {
+		end := start &^ 3
+		return end, offset + decode.ControlLen(ctrls[pos/4:end/4]), prev
+	}
+
+	var scratch [jump * 16]uint32
+	for pos+4 <= start {
+		n := (start - pos) &^ 3
+		if n > len(scratch) {
+			n = len(scratch)
+		}
+		offset += readAllDelta(n, ctrls[pos/4:(pos+n)/4], data[offset:], scratch[:n], prev)
+		prev = scratch[n-1]
+		pos += n
+	}
+	return pos, offset, prev
+}
+
+// readFrom decodes len(out) integers into out starting with the integer at
+// position start of a stream holding count integers, given the group at
+// position pos holding start, the offset of its data and, for
+// differentially coded streams, the value preceding it.
+func readFrom(count int, ctrls, data []byte, pos, offset int, prev uint32, delta bool, start int, out []uint32) {
+	// Decode the group holding start on its own if start is not the first
+	// integer of it.
+	if head := start - pos; head > 0 {
+		var (
+			group [4]uint32
+			n     = groupLen(count, pos)
+			ctrl  = ctrls[pos/4]
+		)
+
+		if delta {
+			offset += decode.GetUint32DeltaScalar(data[offset:], group[:], ctrl, n, prev)
+		} else {
+			offset += decode.GetUint32Scalar(data[offset:], group[:], ctrl, n)
+		}
+
+		prev = group[n-1]
+		out = out[copy(out, group[head:n]):]
+		pos += 4
+	}
+
+	if len(out) == 0 {
+		return
+	}
+
+	rest := ctrls[pos/4 : pos/4+(len(out)+3)/4]
+	if delta {
+		readAllDelta(len(out), rest, data[offset:], out, prev)
+	} else {
+		readAll(len(out), rest, data[offset:], out)
+	}
+}
+
+// groupLen returns the count of integers in the group at position pos of
+// a stream holding count integers.
+func groupLen(count, pos int) int {
+	if count-pos < 4 {
+		return count - pos
+	}
+	return 4
+}
+
+// readAll decodes count integers using the control bytes from ctrls and the
+// data bytes from data with the best implementation available. Returns the
+// number of data bytes read.
+func readAll(count int, ctrls, data []byte, out []uint32) int {
+	return readAllTier(decode.GetTier(), count, ctrls, data, out)
+}
+
+// readAllDelta decodes count differentially coded integers using the
+// control bytes from ctrls and the data bytes from data with the best
+// implementation available. Returns the number of data bytes read.
+func readAllDelta(count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	return readAllDeltaTier(decode.GetTier(), count, ctrls, data, out, prev)
+}
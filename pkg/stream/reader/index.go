@@ -0,0 +1,74 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// indexChunkLen is the count of integers BuildIndexDelta decodes at a time
+// between two checkpoints, which bounds its scratch space regardless of the
+// interval. It is a multiple of 4 like the interval.
+const indexChunkLen = 1 << 12
+
+// BuildIndexDelta builds a skip index over the differentially coded stream
+// holding count integers with a checkpoint every interval integers. Unlike
+// shared.BuildIndex, it has to decode the stream in order to recover the
+// absolute values preceding every checkpoint. See shared.BuildIndex for how
+// interval is interpreted.
+func BuildIndexDelta(count int, stream []byte, prev uint32, interval int) *shared.Index {
+	idx := shared.BuildIndex(count, stream, interval)
+	idx.Delta = true
+	idx.Prevs = make([]uint32, len(idx.Offsets))
+
+	chunkLen := indexChunkLen
+	if idx.Interval < chunkLen {
+		chunkLen = idx.Interval
+	}
+
+	var (
+		ctrls   = stream[:(count+3)/4]
+		data    = stream[len(ctrls):]
+		scratch = make([]uint32, chunkLen)
+	)
+	for k, offset := range idx.Offsets {
+		idx.Prevs[k] = prev
+		if k == len(idx.Offsets)-1 {
+			break
+		}
+
+		// Every checkpoint but the last is followed by a whole interval.
+		for pos, end := k*idx.Interval, (k+1)*idx.Interval; pos < end; pos += chunkLen {
+			n := chunkLen
+			if end-pos < n {
+				n = end - pos
+			}
+			offset += readAllDelta(n, ctrls[pos/4:(pos+n)/4], data[offset:], scratch[:n], prev)
+			prev = scratch[n-1]
+		}
+	}
+
+	return idx
+}
+
+// ReadAllFrom decodes len(out) integers into out starting with the integer
+// at position start of the stream holding count integers. Rather than
+// decoding everything before start, it seeks to the closest checkpoint of
+// idx, which must have been built over stream. Differentially coded
+// streams are reconstructed using the prevs recorded by idx.
+//
+// Note: It is your responsibility to ensure that start+len(out) does not
+// exceed count and that idx can be trusted, since its offsets are used
+// without being checked against stream.
+func ReadAllFrom(count int, stream []byte, idx *shared.Index, start int, out []uint32) {
+	if len(out) == 0 {
+		return
+	}
+
+	var (
+		ctrls             = stream[:(count+3)/4]
+		data              = stream[len(ctrls):]
+		pos, offset, prev = idx.Lookup(start)
+	)
+
+	pos, offset, prev = seek(ctrls, data, pos, offset, prev, idx.Delta, start)
+	readFrom(count, ctrls, data, pos, offset, prev, idx.Delta, start, out)
+}
@@ -0,0 +1,50 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// ReadAllInt32 will read the entire input stream into out according to the
+// Stream VByte format and zigzag decode the integers back into their signed
+// representation. It will select the best implementation depending on the
+// presence of special hardware instructions.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAllInt32(count int, stream []byte, out []int32) {
+	ReadAllInt32N(count, stream, out)
+}
+
+// ReadAllInt32N works similarly to ReadAllInt32 except that it returns the
+// number of bytes of stream consumed, which StreamLen also returns since
+// zigzag encoding does not change the layout of the stream.
+func ReadAllInt32N(count int, stream []byte, out []int32) int {
+	ctrlLen := (count + 3) / 4
+	return ctrlLen + readAllInt32(count, stream[:ctrlLen], stream[ctrlLen:], out)
+}
+
+// readAllInt32 decodes count zigzag encoded integers using the control bytes
+// from ctrls and the data bytes from data. Returns the number of data bytes
+// read.
+func readAllInt32(count int, ctrls, data []byte, out []int32) int {
+	var (
+		dataPos = 0
+		decoded = 0
+	)
+
+	// The kernel loads 16 bytes at a time like Get8uint32, hence the same
+	// limit as readAllFast.
+	for ; count-decoded >= 8 && safeLoad(data, dataPos, ctrls[decoded/4]); decoded += 8 {
+		ctrl := uint16(ctrls[decoded/4]) | uint16(ctrls[decoded/4+1])<<8
+		decode.Get8int32(data[dataPos:], out[decoded:], ctrl)
+		dataPos += shared.ControlByteToSizeTwo(ctrl)
+	}
+
+	for ; decoded < count; decoded += 4 {
+		dataPos += decode.GetInt32Scalar(data[dataPos:], out[decoded:], ctrls[decoded/4], groupLen(count, decoded))
+	}
+
+	return dataPos
+}
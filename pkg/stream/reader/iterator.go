@@ -0,0 +1,128 @@
+package reader
+
+import (
+	"sort"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// iteratorBlockLen is the count of integers a DeltaIterator decodes at a
+// time.
+const iteratorBlockLen = 128
+
+// DeltaIterator walks over a differentially coded stream of sorted
+// integers, e.g. a posting list produced by writer.WriteAllDelta, decoding
+// it a block at a time. When a skip index built over the stream is
+// provided, SeekGE uses its checkpoints to skip over blocks whose last
+// integer is below the target without decoding them. A DeltaIterator is
+// not safe for concurrent use.
+type DeltaIterator struct {
+	count  int
+	ctrls  []byte
+	data   []byte
+	idx    *shared.Index
+	pos    int
+	offset int
+	prev   uint32
+	block  [iteratorBlockLen]uint32
+	n      int
+	i      int
+}
+
+// NewDeltaIterator returns a DeltaIterator over the differentially coded
+// stream holding count integers. The index idx is optional. The iterator
+// is positioned before the first integer, thus Next or SeekGE must be
+// called before Value.
+func NewDeltaIterator(count int, stream []byte, prev uint32, idx *shared.Index) *DeltaIterator {
+	ctrls := stream[:(count+3)/4]
+	return &DeltaIterator{
+		count: count,
+		ctrls: ctrls,
+		data:  stream[len(ctrls):],
+		idx:   idx,
+		prev:  prev,
+		i:     -1,
+	}
+}
+
+// Value returns the integer the iterator is positioned at.
+func (it *DeltaIterator) Value() uint32 {
+	return it.block[it.i]
+}
+
+// Next moves the iterator to the next integer. It returns false once the
+// end of the stream has been reached.
+func (it *DeltaIterator) Next() bool {
+	it.i++
+	if it.i < it.n {
+		return true
+	}
+	return it.fill()
+}
+
+// SeekGE moves the iterator to the first integer at or after its current
+// position that is greater than or equal to target. It returns false if
+// there is no such integer.
+func (it *DeltaIterator) SeekGE(target uint32) bool {
+	if it.i < 0 && !it.Next() {
+		return false
+	}
+	if it.i >= it.n {
+		return false
+	}
+
+	if it.block[it.n-1] < target {
+		it.skip(target)
+		for {
+			if !it.fill() {
+				return false
+			}
+			if it.block[it.n-1] >= target {
+				break
+			}
+		}
+	}
+
+	rest := it.block[it.i:it.n]
+	it.i += sort.Search(len(rest), func(j int) bool {
+		return rest[j] >= target
+	})
+	return true
+}
+
+// skip jumps over the blocks of the index whose last integer is below
+// target, provided that they come after the current position.
+func (it *DeltaIterator) skip(target uint32) {
+	if it.idx == nil || len(it.idx.Prevs) == 0 {
+		return
+	}
+
+	prevs := it.idx.Prevs
+	k := sort.Search(len(prevs)-1, func(k int) bool {
+		return prevs[k+1] >= target
+	})
+	if pos := k * it.idx.Interval; pos > it.pos {
+		it.pos, it.offset, it.prev = pos, it.idx.Offsets[k], prevs[k]
+	}
+}
+
+// fill decodes the next block of integers and positions the iterator at
+// its first integer. It returns false once the end of the stream has been
+// reached.
+func (it *DeltaIterator) fill() bool {
+	n := it.count - it.pos
+	if n <= 0 {
+		it.i, it.n = 0, 0
+		return false
+	}
+	if n > iteratorBlockLen {
+		n = iteratorBlockLen
+	}
+
+	ctrls := it.ctrls[it.pos/4 : (it.pos+n+3)/4]
+	it.offset += readAllDelta(n, ctrls, it.data[it.offset:], it.block[:n], it.prev)
+	it.prev = it.block[n-1]
+	it.pos += n
+	it.i, it.n = 0, n
+	return true
+}
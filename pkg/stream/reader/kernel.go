@@ -0,0 +1,71 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// getKernels and getDeltaKernels hold the kernel selected at every tier so
+// that the funcs taking a tier don't take the registry lock on every call.
+var getKernels, getDeltaKernels [shared.TierAVX2 + 1]shared.Kernel
+
+func init() {
+	setImpls()
+	shared.OnReselect(setImpls)
+}
+
+// setImpls selects the kernels used at every tier according to the
+// registered kernels.
+func setImpls() {
+	for t := shared.TierScalar; t <= shared.TierAVX2; t++ {
+		getKernels[t] = shared.Select(decode.OpGet8uint32, t)
+		getDeltaKernels[t] = shared.Select(decode.OpGet8uint32Delta, t)
+	}
+}
+
+// readAllKernel decodes count integers using the control bytes from ctrls
+// and the data bytes from data using the registered kernel get8 8 integers
+// at a time. Returns the number of data bytes read.
+func readAllKernel(get8 func([]byte, []uint32, uint16), count int, ctrls, data []byte, out []uint32) int {
+	var (
+		dataPos = 0
+		decoded = 0
+	)
+
+	// The kernel may load 16 bytes at a time like the built-in ones, hence
+	// the same limit as readAllFast.
+	for ; count-decoded >= 8 && safeLoad(data, dataPos, ctrls[decoded/4]); decoded += 8 {
+		ctrl := uint16(ctrls[decoded/4]) | uint16(ctrls[decoded/4+1])<<8
+		get8(data[dataPos:], out[decoded:decoded+8], ctrl)
+		dataPos += shared.ControlByteToSizeTwo(ctrl)
+	}
+
+	return dataPos + readAllScalar(count-decoded, ctrls[decoded/4:], data[dataPos:], out[decoded:])
+}
+
+// readAllDeltaKernel decodes count differentially coded integers using the
+// control bytes from ctrls and the data bytes from data using the
+// registered kernel get8 8 integers at a time. Returns the number of data
+// bytes read.
+func readAllDeltaKernel(get8 func([]byte, []uint32, uint16, uint32), count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	var (
+		dataPos = 0
+		decoded = 0
+	)
+	for ; count-decoded >= 8 && safeLoad(data, dataPos, ctrls[decoded/4]); decoded += 8 {
+		ctrl := uint16(ctrls[decoded/4]) | uint16(ctrls[decoded/4+1])<<8
+		get8(data[dataPos:], out[decoded:decoded+8], ctrl, prev)
+		dataPos += shared.ControlByteToSizeTwo(ctrl)
+		prev = out[decoded+7]
+	}
+
+	return dataPos + readAllDeltaScalar(count-decoded, ctrls[decoded/4:], data[dataPos:], out[decoded:], prev)
+}
+
+// safeLoad reports whether the kernels can decode the group of 8 integers
+// whose data starts at dataPos and whose first control byte is ctrl. Their
+// second 16 byte load starts at the data of the second control byte and
+// must not reach past the end of data.
+func safeLoad(data []byte, dataPos int, ctrl uint8) bool {
+	return dataPos+shared.ControlByteToSize(ctrl)+16 <= len(data)
+}
@@ -0,0 +1,140 @@
+package reader
+
+import (
+	"math/bits"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// StreamLen returns the number of bytes taken up by the stream of count
+// integers at the start of stream, i.e. the number of bytes ReadAllN
+// consumes. It is computed from the control bytes alone, which makes it
+// possible to walk a buffer of streams written back to back given only
+// their counts. The shared.StreamPadding bytes following a stream written
+// with shared.Options.Padded are not included.
+func StreamLen(count int, stream []byte) int {
+	ctrlLen := (count + 3) / 4
+	return ctrlLen + DataLen(count, stream[:ctrlLen])
+}
+
+// DataLen returns the number of data bytes taken up by the first count
+// integers described by ctrl, which only holds control bytes, e.g. as
+// written by writer.WriteAllSplit. It is also the offset of the data bytes
+// of the integers following them.
+func DataLen(count int, ctrl []byte) int {
+	size := decode.ControlLen(ctrl[:count/4])
+	if rem := count % 4; rem != 0 {
+		size += partialSize(shared.PerNumLenTable, ctrl[count/4], rem)
+	}
+	return size
+}
+
+// StreamLenVariant works similarly to StreamLen except that the stream is
+// read according to the provided variant of Stream VByte.
+func StreamLenVariant(count int, stream []byte, v shared.Variant) int {
+	if v != shared.Variant0124 {
+		return StreamLen(count, stream)
+	}
+
+	ctrlLen := (count + 3) / 4
+	lens := v.PerControlLenTable()
+	size := 0
+	for _, ctrl := range stream[:count/4] {
+		size += int(lens[ctrl])
+	}
+	if rem := count % 4; rem != 0 {
+		size += partialSize(v.PerNumLenTable(), stream[count/4], rem)
+	}
+	return ctrlLen + size
+}
+
+// StreamLen64 works similarly to StreamLen except that the stream is read
+// according to the 64-bit variant of Stream VByte, i.e. it returns the
+// number of bytes ReadAll64N consumes.
+func StreamLen64(count int, stream []byte) int {
+	ctrlLen := (count + 3) / 4
+	size := 0
+	for _, ctrl := range stream[:count/4] {
+		size += shared.ControlByteToSize64(ctrl)
+	}
+	if rem := count % 4; rem != 0 {
+		size += partialSize(shared.PerNumLenTable64, stream[count/4], rem)
+	}
+	return ctrlLen + size
+}
+
+// StreamLen16 works similarly to StreamLen except that the stream is read
+// according to the 16-bit variant of Stream VByte, i.e. it returns the
+// number of bytes ReadAll16N consumes.
+func StreamLen16(count int, stream []byte) int {
+	ctrlLen := (count + 7) / 8
+	size := 0
+	for _, ctrl := range stream[:count/8] {
+		size += shared.ControlByteToSize16(ctrl)
+	}
+	if rem := count % 8; rem != 0 {
+		// Every integer takes up 1 byte plus 1 if its bit is set.
+		size += rem + bits.OnesCount8(stream[count/8]&(1<<rem-1))
+	}
+	return ctrlLen + size
+}
+
+// ReadAllN works similarly to ReadAll except that it returns the number of
+// bytes of stream consumed, so that the streams of several lists can be
+// written back to back and read one after the other.
+func ReadAllN(count int, stream []byte, out []uint32) int {
+	return ReadAllOptionsN(count, stream, out, shared.Options{})
+}
+
+// ReadAllDeltaN works similarly to ReadAllDelta except that it returns the
+// number of bytes of stream consumed.
+func ReadAllDeltaN(count int, stream []byte, out []uint32, prev uint32) int {
+	return ReadAllDeltaOptionsN(count, stream, out, prev, shared.Options{})
+}
+
+// ReadAllOptionsN works similarly to ReadAllOptions except that it returns
+// the number of bytes of stream consumed. If opts.Padded is set, they
+// include the shared.StreamPadding bytes following the stream.
+func ReadAllOptionsN(count int, stream []byte, out []uint32, opts shared.Options) int {
+	ctrlLen := (count + 3) / 4
+	if opts.Padded {
+		read := readAllPaddedTier(tierOf(opts), count, stream[:ctrlLen], stream[ctrlLen:], out)
+		return ctrlLen + read + shared.StreamPadding
+	}
+	return ctrlLen + readAllTier(tierOf(opts), count, stream[:ctrlLen], stream[ctrlLen:], out)
+}
+
+// ReadAllDeltaOptionsN works similarly to ReadAllDeltaOptions except that
+// it returns the number of bytes of stream consumed the same way as
+// ReadAllOptionsN.
+func ReadAllDeltaOptionsN(count int, stream []byte, out []uint32, prev uint32, opts shared.Options) int {
+	ctrlLen := (count + 3) / 4
+	if opts.Padded {
+		read := readAllDeltaPaddedTier(tierOf(opts), count, stream[:ctrlLen], stream[ctrlLen:], out, prev)
+		return ctrlLen + read + shared.StreamPadding
+	}
+	return ctrlLen + readAllDeltaTier(tierOf(opts), count, stream[:ctrlLen], stream[ctrlLen:], out, prev)
+}
+
+// ReadAllVariantN works similarly to ReadAllVariant except that it returns
+// the number of bytes of stream consumed.
+func ReadAllVariantN(count int, stream []byte, out []uint32, v shared.Variant) int {
+	if v != shared.Variant0124 {
+		return ReadAllN(count, stream, out)
+	}
+
+	ctrlLen := (count + 3) / 4
+	return ctrlLen + readAll0124(count, stream[:ctrlLen], stream[ctrlLen:], out)
+}
+
+// ReadAllDeltaVariantN works similarly to ReadAllDeltaVariant except that
+// it returns the number of bytes of stream consumed.
+func ReadAllDeltaVariantN(count int, stream []byte, out []uint32, prev uint32, v shared.Variant) int {
+	if v != shared.Variant0124 {
+		return ReadAllDeltaN(count, stream, out, prev)
+	}
+
+	ctrlLen := (count + 3) / 4
+	return ctrlLen + readAllDelta0124(count, stream[:ctrlLen], stream[ctrlLen:], out, prev)
+}
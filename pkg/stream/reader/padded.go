@@ -0,0 +1,48 @@
+package reader
+
+import "github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+
+// readAllPaddedTier decodes count integers using the control bytes from
+// ctrls and the data bytes from data, which must be followed by at least
+// shared.StreamPadding bytes, with the kernel selected at tier t. Returns
+// the number of data bytes read.
+func readAllPaddedTier(t shared.Tier, count int, ctrls, data []byte, out []uint32) int {
+	switch k := getKernels[t]; k.Name {
+	case shared.KernelSSE41, shared.KernelAVX2:
+		return readAllPaddedFast(k.Tier, count, ctrls, data, out)
+	}
+	return readAllTier(t, count, ctrls, data, out)
+}
+
+// readAllDeltaPaddedTier decodes count differentially coded integers using
+// the control bytes from ctrls and the data bytes from data, which must be
+// followed by at least shared.StreamPadding bytes, with the kernel selected
+// at tier t. Returns the number of data bytes read.
+func readAllDeltaPaddedTier(t shared.Tier, count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	switch k := getDeltaKernels[t]; k.Name {
+	case shared.KernelSSE41, shared.KernelAVX2:
+		return readAllDeltaPaddedFast(k.Tier, count, ctrls, data, out, prev)
+	}
+	return readAllDeltaTier(t, count, ctrls, data, out, prev)
+}
+
+// paddedTailCtrl returns the control bytes of the last partial group of
+// count integers, i.e. fewer than 8, from ctrls. The codes of the missing
+// integers are cleared so that they take up a single byte each, which is
+// what shared.StreamPadding accounts for.
+func paddedTailCtrl(ctrls []byte, count int) uint16 {
+	ctrl := uint16(ctrls[0])
+	if len(ctrls) > 1 {
+		ctrl |= uint16(ctrls[1]) << 8
+	}
+	return ctrl & (1<<(2*count) - 1)
+}
+
+// paddedTailSize returns the number of data bytes used by the first count
+// integers described by ctrl.
+func paddedTailSize(ctrl uint16, count int) int {
+	if count <= 4 {
+		return partialSize(shared.PerNumLenTable, uint8(ctrl), count)
+	}
+	return shared.ControlByteToSize(uint8(ctrl)) + partialSize(shared.PerNumLenTable, uint8(ctrl>>8), count-4)
+}
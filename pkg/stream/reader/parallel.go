@@ -0,0 +1,79 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/stream/internal/parallel"
+)
+
+// ReadAllParallel works similarly to ReadAll except that the stream is
+// split into chunks which are decoded concurrently by up to workers
+// goroutines. A workers value less than 1 selects runtime.GOMAXPROCS. The
+// data offset of every chunk is first computed by summing up the lengths
+// described by its control bytes, which is done concurrently as well.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAllParallel(count int, stream []byte, out []uint32, workers int) {
+	ctrls, data, offsets := chunkOffsets(count, stream, workers)
+	parallel.ForEach(len(offsets), workers, func(c int) {
+		start, end := parallel.Bounds(count, c)
+		readAll(end-start, ctrls[start/4:(end+3)/4], data[offsets[c]:], out[start:end])
+	})
+}
+
+// ReadAllDeltaParallel works similarly to ReadAllDelta except that the
+// stream is decoded concurrently the same way as ReadAllParallel. Since
+// the prev of a chunk depends on every chunk before it, the chunks are
+// first decoded as if their prev was 0, which leaves the sum of their
+// diffs in their last integer. The prefix sums of those make up the
+// actual prev of every chunk, which is then added to all of its integers
+// in a second pass.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAllDeltaParallel(count int, stream []byte, out []uint32, prev uint32, workers int) {
+	ctrls, data, offsets := chunkOffsets(count, stream, workers)
+	parallel.ForEach(len(offsets), workers, func(c int) {
+		start, end := parallel.Bounds(count, c)
+		readAllDelta(end-start, ctrls[start/4:(end+3)/4], data[offsets[c]:], out[start:end], 0)
+	})
+
+	bases := make([]uint32, len(offsets))
+	for c := range bases {
+		bases[c] = prev
+		_, end := parallel.Bounds(count, c)
+		prev += out[end-1]
+	}
+
+	parallel.ForEach(len(offsets), workers, func(c int) {
+		if base := bases[c]; base != 0 {
+			start, end := parallel.Bounds(count, c)
+			for i := start; i < end; i++ {
+				out[i] += base
+			}
+		}
+	})
+}
+
+// chunkOffsets splits stream into its control bytes and data bytes and
+// computes the chunk offset table, i.e. the offset of the first data byte
+// of every chunk.
+func chunkOffsets(count int, stream []byte, workers int) (ctrls, data []byte, offsets []int) {
+	ctrls = stream[:(count+3)/4]
+	data = stream[len(ctrls):]
+	offsets = make([]int, parallel.Chunks(count))
+
+	// Only full chunks precede another, so the partially filled control
+	// byte of the last one never needs to be accounted for.
+	parallel.ForEach(len(offsets)-1, workers, func(c int) {
+		start, end := parallel.Bounds(count, c)
+		offsets[c+1] = decode.ControlLen(ctrls[start/4 : end/4])
+	})
+	for c := 1; c < len(offsets); c++ {
+		offsets[c] += offsets[c-1]
+	}
+
+	return ctrls, data, offsets
+}
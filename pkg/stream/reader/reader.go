@@ -18,11 +18,7 @@ const (
 // appropriately sized as well as tracking the count of integers in the
 // stream.
 func ReadAll(count int, stream []byte, out []uint32) {
-	if decode.GetMode() == shared.Fast {
-		ReadAllFast(count, stream, out)
-	} else {
-		ReadAllScalar(count, stream, out)
-	}
+	ReadAllOptions(count, stream, out, shared.Options{})
 }
 
 // ReadAllDelta will read the entire input stream into out according to the
@@ -34,10 +30,53 @@ func ReadAll(count int, stream []byte, out []uint32) {
 // appropriately sized as well as tracking the count of integers in the
 // stream.
 func ReadAllDelta(count int, stream []byte, out []uint32, prev uint32) {
-	if decode.GetMode() == shared.Fast {
-		ReadAllDeltaFast(count, stream, out, prev)
-	} else {
-		ReadAllDeltaScalar(count, stream, out, prev)
+	ReadAllDeltaOptions(count, stream, out, prev, shared.Options{})
+}
+
+// ReadAllOptions works similarly to ReadAll except that the implementation
+// is selected by opts for this call only, e.g. to compare the scalar and
+// accelerated implementations within the same process. If opts.Padded is
+// set, stream must have been written with it as well.
+func ReadAllOptions(count int, stream []byte, out []uint32, opts shared.Options) {
+	ReadAllOptionsN(count, stream, out, opts)
+}
+
+// ReadAllDeltaOptions works similarly to ReadAllDelta except that the
+// implementation is selected by opts for this call only.
+func ReadAllDeltaOptions(count int, stream []byte, out []uint32, prev uint32, opts shared.Options) {
+	ReadAllDeltaOptionsN(count, stream, out, prev, opts)
+}
+
+// tierOf returns the tier of kernels to use for a call configured with opts.
+func tierOf(opts shared.Options) shared.Tier {
+	return opts.Tier(decode.GetTier(), decode.SupportedTier())
+}
+
+// readAllTier decodes count integers using the control bytes from ctrls and
+// the data bytes from data with the kernel selected at tier t. Returns the
+// number of data bytes read.
+func readAllTier(t shared.Tier, count int, ctrls, data []byte, out []uint32) int {
+	switch k := getKernels[t]; k.Name {
+	case shared.KernelScalar:
+		return readAllScalar(count, ctrls, data, out)
+	case shared.KernelSSE41, shared.KernelAVX2:
+		return readAllFast(k.Tier, count, ctrls, data, out)
+	default:
+		return readAllKernel(k.Func.(func([]byte, []uint32, uint16)), count, ctrls, data, out)
+	}
+}
+
+// readAllDeltaTier decodes count differentially coded integers using the
+// control bytes from ctrls and the data bytes from data with the kernel
+// selected at tier t. Returns the number of data bytes read.
+func readAllDeltaTier(t shared.Tier, count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	switch k := getDeltaKernels[t]; k.Name {
+	case shared.KernelScalar:
+		return readAllDeltaScalar(count, ctrls, data, out, prev)
+	case shared.KernelSSE41, shared.KernelAVX2:
+		return readAllDeltaFast(k.Tier, count, ctrls, data, out, prev)
+	default:
+		return readAllDeltaKernel(k.Func.(func([]byte, []uint32, uint16, uint32)), count, ctrls, data, out, prev)
 	}
 }
 
@@ -48,10 +87,15 @@ func ReadAllDelta(count int, stream []byte, out []uint32, prev uint32) {
 // appropriately sized as well as tracking the count of integers in the
 // stream.
 func ReadAllScalar(count int, stream []byte, out []uint32) {
-	var (
-		ctrlLen = (count + 3) / 4
+	ctrlLen := (count + 3) / 4
+	readAllScalar(count, stream[:ctrlLen], stream[ctrlLen:], out)
+}
 
-		dataPos    = ctrlLen
+// readAllScalar decodes count integers using the control bytes from ctrls
+// and the data bytes from data. Returns the number of data bytes read.
+func readAllScalar(count int, ctrls, data []byte, out []uint32) int {
+	var (
+		dataPos    = 0
 		ctrlPos    = 0
 		decoded    = 0
 		lowestJump = count &^ (jump - 1)
@@ -59,24 +103,24 @@ func ReadAllScalar(count int, stream []byte, out []uint32) {
 	)
 
 	for ; decoded < lowestJump; decoded += jump {
-		data := stream[dataPos:]
-		ctrls := stream[ctrlPos : ctrlPos+jumpCtrl]
+		in := data[dataPos:]
+		quad := ctrls[ctrlPos : ctrlPos+jumpCtrl]
 		nums := out[decoded : decoded+jump]
 
-		ctrl := ctrls[0]
-		decode.Get4uint32Scalar(data, nums, ctrl)
+		ctrl := quad[0]
+		decode.Get4uint32Scalar(in, nums, ctrl)
 		sizeA := shared.ControlByteToSize(ctrl)
 
-		ctrl = ctrls[1]
-		decode.Get4uint32Scalar(data[sizeA:], nums[4:], ctrl)
+		ctrl = quad[1]
+		decode.Get4uint32Scalar(in[sizeA:], nums[4:], ctrl)
 		sizeB := shared.ControlByteToSize(ctrl)
 
-		ctrl = ctrls[2]
-		decode.Get4uint32Scalar(data[sizeA+sizeB:], nums[8:], ctrl)
+		ctrl = quad[2]
+		decode.Get4uint32Scalar(in[sizeA+sizeB:], nums[8:], ctrl)
 		sizeC := shared.ControlByteToSize(ctrl)
 
-		ctrl = ctrls[3]
-		decode.Get4uint32Scalar(data[sizeA+sizeB+sizeC:], nums[12:], ctrl)
+		ctrl = quad[3]
+		decode.Get4uint32Scalar(in[sizeA+sizeB+sizeC:], nums[12:], ctrl)
 		sizeD := shared.ControlByteToSize(ctrl)
 
 		dataPos += sizeA + sizeB + sizeC + sizeD
@@ -84,16 +128,18 @@ func ReadAllScalar(count int, stream []byte, out []uint32) {
 	}
 
 	for ; decoded < lowest4; decoded += 4 {
-		ctrl := stream[ctrlPos]
-		decode.Get4uint32Scalar(stream[dataPos:], out[decoded:], ctrl)
+		ctrl := ctrls[ctrlPos]
+		decode.Get4uint32Scalar(data[dataPos:], out[decoded:], ctrl)
 		size := shared.ControlByteToSize(ctrl)
 		dataPos += size
 		ctrlPos++
 	}
 
 	if lowest4 != count {
-		decode.GetUint32Scalar(stream[dataPos:], out[decoded:], stream[ctrlPos], count-lowest4)
+		dataPos += decode.GetUint32Scalar(data[dataPos:], out[decoded:], ctrls[ctrlPos], count-lowest4)
 	}
+
+	return dataPos
 }
 
 // ReadAllDeltaScalar will read the entire input stream into out according to the
@@ -104,10 +150,16 @@ func ReadAllScalar(count int, stream []byte, out []uint32) {
 // appropriately sized as well as tracking the count of integers in the
 // stream.
 func ReadAllDeltaScalar(count int, stream []byte, out []uint32, prev uint32) {
-	var (
-		ctrlLen = (count + 3) / 4
+	ctrlLen := (count + 3) / 4
+	readAllDeltaScalar(count, stream[:ctrlLen], stream[ctrlLen:], out, prev)
+}
 
-		dataPos    = ctrlLen
+// readAllDeltaScalar decodes count differentially coded integers using the
+// control bytes from ctrls and the data bytes from data. Returns the number
+// of data bytes read.
+func readAllDeltaScalar(count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	var (
+		dataPos    = 0
 		ctrlPos    = 0
 		decoded    = 0
 		lowestJump = count &^ (jump - 1)
@@ -115,24 +167,24 @@ func ReadAllDeltaScalar(count int, stream []byte, out []uint32, prev uint32) {
 	)
 
 	for ; decoded < lowestJump; decoded += jump {
-		data := stream[dataPos:]
-		ctrls := stream[ctrlPos : ctrlPos+jumpCtrl]
+		in := data[dataPos:]
+		quad := ctrls[ctrlPos : ctrlPos+jumpCtrl]
 		nums := out[decoded : decoded+jump]
 
-		ctrl := ctrls[0]
-		decode.Get4uint32DeltaScalar(data, nums, ctrl, prev)
+		ctrl := quad[0]
+		decode.Get4uint32DeltaScalar(in, nums, ctrl, prev)
 		sizeA := shared.ControlByteToSize(ctrl)
 
-		ctrl = ctrls[1]
-		decode.Get4uint32DeltaScalar(data[sizeA:], nums[4:], ctrl, nums[3])
+		ctrl = quad[1]
+		decode.Get4uint32DeltaScalar(in[sizeA:], nums[4:], ctrl, nums[3])
 		sizeB := shared.ControlByteToSize(ctrl)
 
-		ctrl = ctrls[2]
-		decode.Get4uint32DeltaScalar(data[sizeA+sizeB:], nums[8:], ctrl, nums[7])
+		ctrl = quad[2]
+		decode.Get4uint32DeltaScalar(in[sizeA+sizeB:], nums[8:], ctrl, nums[7])
 		sizeC := shared.ControlByteToSize(ctrl)
 
-		ctrl = ctrls[3]
-		decode.Get4uint32DeltaScalar(data[sizeA+sizeB+sizeC:], nums[12:], ctrl, nums[11])
+		ctrl = quad[3]
+		decode.Get4uint32DeltaScalar(in[sizeA+sizeB+sizeC:], nums[12:], ctrl, nums[11])
 		sizeD := shared.ControlByteToSize(ctrl)
 
 		dataPos += sizeA + sizeB + sizeC + sizeD
@@ -141,8 +193,8 @@ func ReadAllDeltaScalar(count int, stream []byte, out []uint32, prev uint32) {
 	}
 
 	for ; decoded < lowest4; decoded += 4 {
-		ctrl := stream[ctrlPos]
-		decode.Get4uint32DeltaScalar(stream[dataPos:], out[decoded:], ctrl, prev)
+		ctrl := ctrls[ctrlPos]
+		decode.Get4uint32DeltaScalar(data[dataPos:], out[decoded:], ctrl, prev)
 		size := shared.ControlByteToSize(ctrl)
 		dataPos += size
 		ctrlPos++
@@ -150,6 +202,8 @@ func ReadAllDeltaScalar(count int, stream []byte, out []uint32, prev uint32) {
 	}
 
 	if lowest4 != count {
-		decode.GetUint32DeltaScalar(stream[dataPos:], out[decoded:], stream[ctrlPos], count-lowest4, prev)
+		dataPos += decode.GetUint32DeltaScalar(data[dataPos:], out[decoded:], ctrls[ctrlPos], count-lowest4, prev)
 	}
+
+	return dataPos
 }
@@ -8,96 +8,113 @@ import (
 )
 
 // ReadAllFast will read the entire input stream into out according to the
-// Stream VByte format using special hardware instructions.
+// Stream VByte format using special hardware instructions. It uses the
+// highest tier the CPU supports regardless of the shared.Mode, or the scalar
+// implementation if there is none or after a shared.Fallback.
 //
 // Note: It is your responsibility to ensure that the incoming slices are
 // appropriately sized as well as tracking the count of integers in the
 // stream.
 func ReadAllFast(count int, stream []byte, out []uint32) {
+	ctrlLen := (count + 3) / 4
+	readAllFast(decode.SupportedTier(), count, stream[:ctrlLen], stream[ctrlLen:], out)
+}
+
+// readAllFast decodes count integers using the control bytes from ctrls
+// and the data bytes from data with the SSE kernels if t is
+// shared.TierSSE41, the scalar implementation if t is shared.TierScalar and
+// the AVX ones otherwise. The last groups are decoded one control byte at a
+// time with decode.GetNuint32Fast, which reads no further than their data
+// bytes, or with the scalar implementation since the SSE kernels lack
+// masked moves. Returns the number of data bytes read.
+func readAllFast(t shared.Tier, count int, ctrls, data []byte, out []uint32) int {
+	if t == shared.TierScalar {
+		return readAllScalar(count, ctrls, data, out)
+	}
+
+	get8, getN := decode.Get8uint32FastAsm, decode.GetNuint32Fast
+	if t == shared.TierSSE41 {
+		get8, getN = decode.Get8uint32SSEAsm, decode.GetUint32Scalar
+	}
+
 	var (
 		ctrlPos = 0
 		decoded = 0
-		dataPos = (count + 3) / 4
-		ctrlLen = dataPos
+		dataPos = 0
+		ctrlLen = len(ctrls)
 		// lowest32 is the limit for the count of integers we'll read in
-		// bulk 8 at a time directly from the input stream. We subtract 3
-		// here since we load 16 bytes at a time in the assembly code. If
-		// you attempt to load the last few control bytes worth of data,
-		// it's possible there won't be enough bytes in the data stream to
-		// support it, which can lead to loading from uninitialized memory.
-		//
-		// [ _ _ _ _ | _ _ _ _ | _ _ _ _ | _ _ _ _ ]
-		//                    works fine --^ ^-- bad things here
-		//
-		// Imagine the last group of 16 in the above array is all encoded with
-		// 4 bytes. Decoding the first 4 integers in that group will work fine,
-		// since it will load the last 3 (unused) bytes. However, when attempting
-		// to decode the last three groups of 4, each load will need an extra
-		// 1, 2, or 3 bytes (respectively) in order to be considered safe.
-		lowest32 = ((ctrlLen - 3) * 4) &^ 31
+		// bulk 32 at a time directly from the input stream. Given two
+		// control bytes, the kernels load 16 bytes from the data of each
+		// of them, which may reach up to 15 bytes past the data of the
+		// last one. They are thus only used while those loads stay within
+		// data, see safeLoad, and the remaining groups are decoded without
+		// reading past their data bytes.
+		lowest32 = count &^ 31
 	)
 
 	for ; decoded < lowest32; decoded += 32 {
-		data := stream[dataPos:]
-		ctrls := stream[ctrlPos : ctrlPos+8]
+		octet := ctrls[ctrlPos : ctrlPos+8]
+		sizeA := shared.ControlByteToSize(octet[0]) + shared.ControlByteToSize(octet[1])
+		sizeB := shared.ControlByteToSize(octet[2]) + shared.ControlByteToSize(octet[3])
+		sizeC := shared.ControlByteToSize(octet[4]) + shared.ControlByteToSize(octet[5])
+		if !safeLoad(data, dataPos+sizeA+sizeB+sizeC, octet[6]) {
+			break
+		}
+
+		in := data[dataPos:]
 		nums := out[decoded : decoded+32]
 
-		ctrl := uint16(ctrls[0]) | uint16(ctrls[1])<<8
-		decode.Get8uint32FastAsm(
-			data,
+		ctrl := uint16(octet[0]) | uint16(octet[1])<<8
+		get8(
+			in,
 			nums,
 			ctrl,
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		sizeA := shared.ControlByteToSize(ctrls[0]) + shared.ControlByteToSize(ctrls[1])
 
-		ctrl = uint16(ctrls[2]) | uint16(ctrls[3])<<8
-		decode.Get8uint32FastAsm(
-			data[sizeA:],
+		ctrl = uint16(octet[2]) | uint16(octet[3])<<8
+		get8(
+			in[sizeA:],
 			nums[8:],
 			ctrl,
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		sizeB := shared.ControlByteToSize(ctrls[2]) + shared.ControlByteToSize(ctrls[3])
 
-		ctrl = uint16(ctrls[4]) | uint16(ctrls[5])<<8
-		decode.Get8uint32FastAsm(
-			data[sizeA+sizeB:],
+		ctrl = uint16(octet[4]) | uint16(octet[5])<<8
+		get8(
+			in[sizeA+sizeB:],
 			nums[16:],
 			ctrl,
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		sizeC := shared.ControlByteToSize(ctrls[4]) + shared.ControlByteToSize(ctrls[5])
 
-		ctrl = uint16(ctrls[6]) | uint16(ctrls[7])<<8
-		decode.Get8uint32FastAsm(
-			data[sizeA+sizeB+sizeC:],
+		ctrl = uint16(octet[6]) | uint16(octet[7])<<8
+		get8(
+			in[sizeA+sizeB+sizeC:],
 			nums[24:],
 			ctrl,
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		sizeD := shared.ControlByteToSize(ctrls[6]) + shared.ControlByteToSize(ctrls[7])
+		sizeD := shared.ControlByteToSize(octet[6]) + shared.ControlByteToSize(octet[7])
 
 		dataPos += sizeA + sizeB + sizeC + sizeD
 		ctrlPos += 8
 	}
 
-	// Must be strictly less than the last 4 blocks of integers, since we can't safely
-	// decode 8 if our ctrl pos starts at the first 4 in the block.
-	for ; ctrlPos < ctrlLen-4; ctrlPos += 2 {
-		ctrl := uint16(stream[ctrlPos]) | uint16(stream[ctrlPos+1])<<8
-		decode.Get8uint32FastAsm(
-			stream[dataPos:],
+	for ; count-decoded >= 8 && safeLoad(data, dataPos, ctrls[ctrlPos]); ctrlPos += 2 {
+		ctrl := uint16(ctrls[ctrlPos]) | uint16(ctrls[ctrlPos+1])<<8
+		get8(
+			data[dataPos:],
 			out[decoded:],
 			ctrl,
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		dataPos += shared.ControlByteToSize(stream[ctrlPos]) + shared.ControlByteToSize(stream[ctrlPos+1])
+		dataPos += shared.ControlByteToSize(ctrls[ctrlPos]) + shared.ControlByteToSize(ctrls[ctrlPos+1])
 		decoded += 8
 	}
 
@@ -106,114 +123,129 @@ func ReadAllFast(count int, stream []byte, out []uint32) {
 		if nums > 4 {
 			nums = 4
 		}
-		dataPos += decode.GetUint32Scalar(
-			stream[dataPos:],
+		dataPos += getN(
+			data[dataPos:],
 			out[decoded:],
-			stream[ctrlPos],
+			ctrls[ctrlPos],
 			nums,
 		)
 		decoded += nums
 	}
+
+	return dataPos
 }
 
 // ReadAllDeltaFast will read the entire input stream into out according to the
 // Stream VByte format using special hardware instructions. It will reconstruct
-// the original non differentially encoded values.
+// the original non differentially encoded values. Like ReadAllFast, it uses
+// the highest supported tier or the scalar implementation.
 //
 // Note: It is your responsibility to ensure that the incoming slices are
 // appropriately sized as well as tracking the count of integers in the
 // stream.
 func ReadAllDeltaFast(count int, stream []byte, out []uint32, prev uint32) {
+	ctrlLen := (count + 3) / 4
+	readAllDeltaFast(decode.SupportedTier(), count, stream[:ctrlLen], stream[ctrlLen:], out, prev)
+}
+
+// readAllDeltaFast decodes count integers using the control bytes from ctrls
+// and the data bytes from data with the SSE kernels if t is
+// shared.TierSSE41, the scalar implementation if t is shared.TierScalar
+// and the AVX ones otherwise. Returns the number of data bytes read.
+func readAllDeltaFast(t shared.Tier, count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	if t == shared.TierScalar {
+		return readAllDeltaScalar(count, ctrls, data, out, prev)
+	}
+
+	get8Delta, getNDelta := decode.Get8uint32DeltaFastAsm, decode.GetNuint32DeltaFast
+	if t == shared.TierSSE41 {
+		get8Delta, getNDelta = decode.Get8uint32DeltaSSEAsm, decode.GetUint32DeltaScalar
+	}
+
 	var (
 		ctrlPos = 0
 		decoded = 0
-		dataPos = (count + 3) / 4
-		ctrlLen = dataPos
+		dataPos = 0
+		ctrlLen = len(ctrls)
 		// lowest32 is the limit for the count of integers we'll read in
-		// bulk 8 at a time directly from the input stream. We subtract 3
-		// here since we load 16 bytes at a time in the assembly code. If
-		// you attempt to load the last few control bytes worth of data,
-		// it's possible there won't be enough bytes in the data stream to
-		// support it, which can lead to loading from uninitialized memory.
-		//
-		// [ _ _ _ _ | _ _ _ _ | _ _ _ _ | _ _ _ _ ]
-		//                    works fine --^ ^-- bad things here
-		//
-		// Imagine the last group of 16 in the above array is all encoded with
-		// 4 bytes. Decoding the first 4 integers in that group will work fine,
-		// since it will load the last 3 (unused) bytes. However, when attempting
-		// to decode the last three groups of 4, each load will need an extra
-		// 1, 2, or 3 bytes (respectively) in order to be considered safe.
-		lowest32 = ((ctrlLen - 3) * 4) &^ 31
+		// bulk 32 at a time directly from the input stream. Given two
+		// control bytes, the kernels load 16 bytes from the data of each
+		// of them, which may reach up to 15 bytes past the data of the
+		// last one. They are thus only used while those loads stay within
+		// data, see safeLoad, and the remaining groups are decoded without
+		// reading past their data bytes.
+		lowest32 = count &^ 31
 	)
 
 	for ; decoded < lowest32; decoded += 32 {
-		data := stream[dataPos:]
-		ctrls := stream[ctrlPos : ctrlPos+8]
+		octet := ctrls[ctrlPos : ctrlPos+8]
+		sizeA := shared.ControlByteToSize(octet[0]) + shared.ControlByteToSize(octet[1])
+		sizeB := shared.ControlByteToSize(octet[2]) + shared.ControlByteToSize(octet[3])
+		sizeC := shared.ControlByteToSize(octet[4]) + shared.ControlByteToSize(octet[5])
+		if !safeLoad(data, dataPos+sizeA+sizeB+sizeC, octet[6]) {
+			break
+		}
+
+		in := data[dataPos:]
 		nums := out[decoded : decoded+32]
 
-		ctrl := uint16(ctrls[0]) | uint16(ctrls[1])<<8
-		decode.Get8uint32DeltaFastAsm(
-			data,
+		ctrl := uint16(octet[0]) | uint16(octet[1])<<8
+		get8Delta(
+			in,
 			nums,
 			ctrl,
 			prev,
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		sizeA := shared.ControlByteToSize(ctrls[0]) + shared.ControlByteToSize(ctrls[1])
 
-		ctrl = uint16(ctrls[2]) | uint16(ctrls[3])<<8
-		decode.Get8uint32DeltaFastAsm(
-			data[sizeA:],
+		ctrl = uint16(octet[2]) | uint16(octet[3])<<8
+		get8Delta(
+			in[sizeA:],
 			nums[8:],
 			ctrl,
 			nums[7],
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		sizeB := shared.ControlByteToSize(ctrls[2]) + shared.ControlByteToSize(ctrls[3])
 
-		ctrl = uint16(ctrls[4]) | uint16(ctrls[5])<<8
-		decode.Get8uint32DeltaFastAsm(
-			data[sizeA+sizeB:],
+		ctrl = uint16(octet[4]) | uint16(octet[5])<<8
+		get8Delta(
+			in[sizeA+sizeB:],
 			nums[16:],
 			ctrl,
 			nums[15],
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		sizeC := shared.ControlByteToSize(ctrls[4]) + shared.ControlByteToSize(ctrls[5])
 
-		ctrl = uint16(ctrls[6]) | uint16(ctrls[7])<<8
-		decode.Get8uint32DeltaFastAsm(
-			data[sizeA+sizeB+sizeC:],
+		ctrl = uint16(octet[6]) | uint16(octet[7])<<8
+		get8Delta(
+			in[sizeA+sizeB+sizeC:],
 			nums[24:],
 			ctrl,
 			nums[23],
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		sizeD := shared.ControlByteToSize(ctrls[6]) + shared.ControlByteToSize(ctrls[7])
+		sizeD := shared.ControlByteToSize(octet[6]) + shared.ControlByteToSize(octet[7])
 
 		dataPos += sizeA + sizeB + sizeC + sizeD
 		ctrlPos += 8
 		prev = nums[31]
 	}
 
-	// Must be strictly less than the last 4 blocks of integers, since we can't safely
-	// decode 8 if our ctrl pos starts at the first 4 in the block.
-	for ; ctrlPos < ctrlLen-4; ctrlPos += 2 {
-		ctrl := uint16(stream[ctrlPos]) | uint16(stream[ctrlPos+1])<<8
-		decode.Get8uint32DeltaFastAsm(
-			stream[dataPos:],
+	for ; count-decoded >= 8 && safeLoad(data, dataPos, ctrls[ctrlPos]); ctrlPos += 2 {
+		ctrl := uint16(ctrls[ctrlPos]) | uint16(ctrls[ctrlPos+1])<<8
+		get8Delta(
+			data[dataPos:],
 			out[decoded:],
 			ctrl,
 			prev,
 			shared.DecodeShuffleTable,
 			shared.PerControlLenTable,
 		)
-		dataPos += shared.ControlByteToSize(stream[ctrlPos]) + shared.ControlByteToSize(stream[ctrlPos+1])
+		dataPos += shared.ControlByteToSize(ctrls[ctrlPos]) + shared.ControlByteToSize(ctrls[ctrlPos+1])
 		decoded += 8
 		prev = out[decoded-1]
 	}
@@ -223,14 +255,116 @@ func ReadAllDeltaFast(count int, stream []byte, out []uint32, prev uint32) {
 		if nums > 4 {
 			nums = 4
 		}
-		dataPos += decode.GetUint32DeltaScalar(
-			stream[dataPos:],
+		dataPos += getNDelta(
+			data[dataPos:],
 			out[decoded:],
-			stream[ctrlPos],
+			ctrls[ctrlPos],
 			nums,
 			prev,
 		)
 		decoded += nums
 		prev = out[decoded-1]
 	}
+
+	return dataPos
+}
+
+// readAllPaddedFast decodes count integers using the control bytes from
+// ctrls and the data bytes from data, which must be followed by at least
+// shared.StreamPadding bytes, with the SSE kernels if t is shared.TierSSE41
+// and the AVX ones otherwise. Unlike readAllFast, every group including the
+// last partial one is decoded with the kernels. Returns the number of data
+// bytes read.
+func readAllPaddedFast(t shared.Tier, count int, ctrls, data []byte, out []uint32) int {
+	get8 := decode.Get8uint32FastAsm
+	if t == shared.TierSSE41 {
+		get8 = decode.Get8uint32SSEAsm
+	}
+
+	var (
+		dataPos = 0
+		decoded = 0
+		lowest8 = count &^ 7
+	)
+
+	for ; decoded < lowest8; decoded += 8 {
+		ctrl := uint16(ctrls[decoded/4]) | uint16(ctrls[decoded/4+1])<<8
+		get8(
+			data[dataPos:],
+			out[decoded:],
+			ctrl,
+			shared.DecodeShuffleTable,
+			shared.PerControlLenTable,
+		)
+		dataPos += shared.ControlByteToSizeTwo(ctrl)
+	}
+
+	if decoded != count {
+		var (
+			nums [8]uint32
+			ctrl = paddedTailCtrl(ctrls[decoded/4:], count-decoded)
+		)
+		get8(
+			data[dataPos:],
+			nums[:],
+			ctrl,
+			shared.DecodeShuffleTable,
+			shared.PerControlLenTable,
+		)
+		copy(out[decoded:count], nums[:])
+		dataPos += paddedTailSize(ctrl, count-decoded)
+	}
+
+	return dataPos
+}
+
+// readAllDeltaPaddedFast decodes count differentially coded integers using
+// the control bytes from ctrls and the data bytes from data, which must be
+// followed by at least shared.StreamPadding bytes, with the SSE kernels if t
+// is shared.TierSSE41 and the AVX ones otherwise. Returns the number of data
+// bytes read.
+func readAllDeltaPaddedFast(t shared.Tier, count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	get8Delta := decode.Get8uint32DeltaFastAsm
+	if t == shared.TierSSE41 {
+		get8Delta = decode.Get8uint32DeltaSSEAsm
+	}
+
+	var (
+		dataPos = 0
+		decoded = 0
+		lowest8 = count &^ 7
+	)
+
+	for ; decoded < lowest8; decoded += 8 {
+		ctrl := uint16(ctrls[decoded/4]) | uint16(ctrls[decoded/4+1])<<8
+		get8Delta(
+			data[dataPos:],
+			out[decoded:],
+			ctrl,
+			prev,
+			shared.DecodeShuffleTable,
+			shared.PerControlLenTable,
+		)
+		dataPos += shared.ControlByteToSizeTwo(ctrl)
+		prev = out[decoded+7]
+	}
+
+	if decoded != count {
+		var (
+			nums [8]uint32
+			ctrl = paddedTailCtrl(ctrls[decoded/4:], count-decoded)
+		)
+		get8Delta(
+			data[dataPos:],
+			nums[:],
+			ctrl,
+			prev,
+			shared.DecodeShuffleTable,
+			shared.PerControlLenTable,
+		)
+		copy(out[decoded:count], nums[:])
+		dataPos += paddedTailSize(ctrl, count-decoded)
+	}
+
+	return dataPos
 }
@@ -2,6 +2,28 @@
 
 package reader
 
+import "github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+
 func ReadAllFast(count int, stream []byte, out []uint32) {
 	panic("unreachable")
 }
+
+func ReadAllDeltaFast(count int, stream []byte, out []uint32, prev uint32) {
+	panic("unreachable")
+}
+
+func readAllFast(t shared.Tier, count int, ctrls, data []byte, out []uint32) int {
+	panic("unreachable")
+}
+
+func readAllDeltaFast(t shared.Tier, count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	panic("unreachable")
+}
+
+func readAllPaddedFast(t shared.Tier, count int, ctrls, data []byte, out []uint32) int {
+	panic("unreachable")
+}
+
+func readAllDeltaPaddedFast(t shared.Tier, count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	panic("unreachable")
+}
@@ -1,11 +1,15 @@
 package reader
 
 import (
+	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/rand"
 	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -52,10 +56,6 @@ func TestReadAllDeltaScalar(t *testing.T) {
 }
 
 func TestReadAllFast(t *testing.T) {
-	if decode.GetMode() == shared.Normal {
-		t.Skipf("Testing environment doesn't support this test")
-	}
-
 	for i := 0; i < 6; i++ {
 		count := int(util.RandUint32() % 1e6)
 		nums := util.GenUint32(count)
@@ -71,10 +71,6 @@ func TestReadAllFast(t *testing.T) {
 }
 
 func TestReadAllDeltaFast(t *testing.T) {
-	if decode.GetMode() == shared.Normal {
-		t.Skipf("Testing environment doesn't support this test")
-	}
-
 	for i := 0; i < 6; i++ {
 		count := int(util.RandUint32() % 1e6)
 		nums := util.GenUint32(count)
@@ -93,6 +89,1103 @@ func TestReadAllDeltaFast(t *testing.T) {
 	}
 }
 
+func TestReadAllTiers(t *testing.T) {
+	defer func() {
+		encode.SetTier(encode.SupportedTier())
+		decode.SetTier(decode.SupportedTier())
+	}()
+	defer shared.SetMode(shared.GetMode())
+	shared.SetMode(shared.Fast)
+
+	for tier := shared.TierScalar; tier <= shared.TierAVX2; tier++ {
+		if encode.SetTier(tier) != nil || decode.SetTier(tier) != nil {
+			continue
+		}
+
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint32(count)
+		prev := util.RandUint32()
+		t.Run(fmt.Sprintf("ReadAll %v: %d", tier, count), func(t *testing.T) {
+			stream := writer.WriteAll(nums)
+			if !reflect.DeepEqual(writer.WriteAllScalar(nums), stream) {
+				t.Fatalf("encoded wrong stream")
+			}
+
+			out := make([]uint32, count)
+			ReadAll(count, stream, out)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			stream = writer.WriteAllDelta(nums, prev)
+			if !reflect.DeepEqual(writer.WriteAllDeltaScalar(nums, prev), stream) {
+				t.Fatalf("encoded wrong delta stream")
+			}
+
+			out = make([]uint32, count)
+			ReadAllDelta(count, stream, out, prev)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong delta nums")
+			}
+		})
+	}
+}
+
+func TestReadAllOptions(t *testing.T) {
+	defer shared.SetMode(shared.GetMode())
+
+	for _, mode := range []shared.PerformanceMode{shared.Normal, shared.Fast} {
+		shared.SetMode(mode)
+		if mode == shared.Normal && decode.GetTier() != shared.TierScalar {
+			t.Fatalf("expected tier %v, got %v", shared.TierScalar, decode.GetTier())
+		}
+
+		for _, impl := range []shared.Impl{shared.ImplDefault, shared.ImplScalar, shared.ImplFast} {
+			count := int(util.RandUint32() % 1e5)
+			nums := util.GenUint32(count)
+			prev := util.RandUint32()
+			opts := shared.Options{Impl: impl}
+			t.Run(fmt.Sprintf("ReadAllOptions %v, %d: %d", mode, impl, count), func(t *testing.T) {
+				out := make([]uint32, count)
+				ReadAllOptions(count, writer.WriteAllScalar(nums), out, opts)
+				if !reflect.DeepEqual(nums, out) {
+					t.Fatalf("decoded wrong nums")
+				}
+
+				out = make([]uint32, count)
+				ReadAllDeltaOptions(count, writer.WriteAllDeltaScalar(nums, prev), out, prev, opts)
+				if !reflect.DeepEqual(nums, out) {
+					t.Fatalf("decoded wrong delta nums")
+				}
+			})
+		}
+	}
+}
+
+func TestRegisterKernel(t *testing.T) {
+	var calls int
+	get8 := func(in []byte, out []uint32, ctrl uint16) {
+		calls++
+		decode.Get8uint32Scalar(in, out, ctrl)
+	}
+	get8Delta := func(in []byte, out []uint32, ctrl uint16, prev uint32) {
+		calls++
+		decode.Get8uint32DeltaScalar(in, out, ctrl, prev)
+	}
+
+	tier := decode.GetTier()
+	for _, k := range []shared.Kernel{
+		{Op: decode.OpGet8uint32, Name: "test", Tier: tier, Func: get8},
+		{Op: decode.OpGet8uint32Delta, Name: "test", Tier: tier, Func: get8Delta},
+	} {
+		if err := shared.Register(k); err != nil {
+			t.Fatalf("unexpected error registering %s: %v", k.Op, err)
+		}
+		defer shared.Unregister(k.Op, k.Name)
+	}
+
+	for _, impl := range shared.Implementations() {
+		if impl.Op == decode.OpGet8uint32 && impl.Selected != (impl.Name == "test") {
+			t.Fatalf("unexpected selection of %s: %s", impl.Name, impl.Reason)
+		}
+	}
+
+	count := int(util.RandUint32()%1e4) + 64
+	nums := util.GenUint32(count)
+	prev := util.RandUint32()
+
+	out := make([]uint32, count)
+	ReadAll(count, writer.WriteAllScalar(nums), out)
+	if !reflect.DeepEqual(nums, out) {
+		t.Fatalf("decoded wrong nums")
+	}
+
+	out = make([]uint32, count)
+	ReadAllDelta(count, writer.WriteAllDeltaScalar(nums, prev), out, prev)
+	if !reflect.DeepEqual(nums, out) {
+		t.Fatalf("decoded wrong delta nums")
+	}
+
+	if calls == 0 {
+		t.Fatalf("registered kernels not used")
+	}
+}
+
+func TestReadAllPadded(t *testing.T) {
+	counts := []int{int(util.RandUint32() % 1e5)}
+	for count := 0; count <= 40; count++ {
+		counts = append(counts, count)
+	}
+
+	for _, count := range counts {
+		nums := util.GenUint32(count)
+		prev := util.RandUint32()
+		for _, impl := range []shared.Impl{shared.ImplScalar, shared.ImplFast} {
+			opts := shared.Options{Impl: impl, Padded: true}
+			stream := writer.WriteAllOptions(nums, opts)
+			if want := writer.WriteAllScalar(nums); !reflect.DeepEqual(append(want, make([]byte, shared.StreamPadding)...), stream) {
+				t.Fatalf("%d: expected %d bytes of padding", count, shared.StreamPadding)
+			}
+
+			out := make([]uint32, count)
+			ReadAllOptions(count, stream, out, opts)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("%d: decoded wrong nums", count)
+			}
+
+			out = make([]uint32, count)
+			if _, err := ReadAllChecked(count, stream, out); err != nil || !reflect.DeepEqual(nums, out) {
+				t.Fatalf("%d: padded stream not decodable by the unpadded reader: %v", count, err)
+			}
+
+			stream = writer.WriteAllDeltaOptions(nums, prev, opts)
+			out = make([]uint32, count)
+			ReadAllDeltaOptions(count, stream, out, prev, opts)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("%d: decoded wrong delta nums", count)
+			}
+
+			out = make([]uint32, count)
+			ReadAllDelta(count, stream, out, prev)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("%d: padded delta stream not decodable by the unpadded reader", count)
+			}
+		}
+	}
+}
+
+func TestReadAllN(t *testing.T) {
+	counts := []int{int(util.RandUint32() % 1e5)}
+	for count := 0; count <= 40; count++ {
+		counts = append(counts, count)
+	}
+
+	var (
+		lists  = make([][]uint32, len(counts))
+		prev   = util.RandUint32()
+		layout = []struct {
+			name  string
+			write func(nums []uint32) []byte
+			read  func(count int, stream []byte, out []uint32) int
+			len   func(count int, stream []byte) int
+		}{
+			{
+				name:  "standard",
+				write: writer.WriteAll,
+				read:  ReadAllN,
+				len:   StreamLen,
+			},
+			{
+				name:  "delta",
+				write: func(nums []uint32) []byte { return writer.WriteAllDelta(nums, prev) },
+				read: func(count int, stream []byte, out []uint32) int {
+					return ReadAllDeltaN(count, stream, out, prev)
+				},
+				len: StreamLen,
+			},
+			{
+				name: "padded",
+				write: func(nums []uint32) []byte {
+					return writer.WriteAllOptions(nums, shared.Options{Padded: true})
+				},
+				read: func(count int, stream []byte, out []uint32) int {
+					return ReadAllOptionsN(count, stream, out, shared.Options{Padded: true})
+				},
+				len: func(count int, stream []byte) int {
+					return StreamLen(count, stream) + shared.StreamPadding
+				},
+			},
+			{
+				name:  "0124",
+				write: func(nums []uint32) []byte { return writer.WriteAllVariant(nums, shared.Variant0124) },
+				read: func(count int, stream []byte, out []uint32) int {
+					return ReadAllVariantN(count, stream, out, shared.Variant0124)
+				},
+				len: func(count int, stream []byte) int {
+					return StreamLenVariant(count, stream, shared.Variant0124)
+				},
+			},
+			{
+				name: "delta 0124",
+				write: func(nums []uint32) []byte {
+					return writer.WriteAllDeltaVariant(nums, prev, shared.Variant0124)
+				},
+				read: func(count int, stream []byte, out []uint32) int {
+					return ReadAllDeltaVariantN(count, stream, out, prev, shared.Variant0124)
+				},
+				len: func(count int, stream []byte) int {
+					return StreamLenVariant(count, stream, shared.Variant0124)
+				},
+			},
+		}
+	)
+	for i, count := range counts {
+		lists[i] = util.GenUint32(count)
+		util.SortUint32(lists[i])
+	}
+
+	for _, l := range layout {
+		var buf []byte
+		for _, nums := range lists {
+			buf = append(buf, l.write(nums)...)
+		}
+
+		pos := 0
+		for i, count := range counts {
+			out := make([]uint32, count)
+			n := l.read(count, buf[pos:], out)
+			if !reflect.DeepEqual(lists[i], out) {
+				t.Fatalf("%s: %d: decoded wrong nums", l.name, count)
+			}
+			if size := l.len(count, buf[pos:]); size != n {
+				t.Fatalf("%s: %d: expected %d bytes, stream len %d", l.name, count, n, size)
+			}
+			pos += n
+		}
+
+		if pos != len(buf) {
+			t.Fatalf("%s: expected %d bytes consumed, actual %d", l.name, len(buf), pos)
+		}
+	}
+}
+
+func TestReadAllWidthsN(t *testing.T) {
+	counts := []int{int(util.RandUint32() % 1e5)}
+	for count := 0; count <= 40; count++ {
+		counts = append(counts, count)
+	}
+
+	var (
+		lists32 = make([][]int32, len(counts))
+		lists64 = make([][]uint64, len(counts))
+		lists16 = make([][]uint16, len(counts))
+		prev64  = util.GenUint64(1)[0]
+		prev16  = util.GenUint16(1)[0]
+		// Every layout writes and reads the i-th list, reporting whether
+		// it decoded correctly.
+		layout = []struct {
+			name  string
+			write func(i int) []byte
+			read  func(i int, stream []byte) (int, bool)
+			len   func(count int, stream []byte) int
+		}{
+			{
+				name:  "int32",
+				write: func(i int) []byte { return writer.WriteAllInt32(lists32[i]) },
+				read: func(i int, stream []byte) (int, bool) {
+					out := make([]int32, counts[i])
+					n := ReadAllInt32N(counts[i], stream, out)
+					return n, reflect.DeepEqual(lists32[i], out)
+				},
+				len: StreamLen,
+			},
+			{
+				name:  "64",
+				write: func(i int) []byte { return writer.WriteAll64(lists64[i]) },
+				read: func(i int, stream []byte) (int, bool) {
+					out := make([]uint64, counts[i])
+					n := ReadAll64N(counts[i], stream, out)
+					return n, reflect.DeepEqual(lists64[i], out)
+				},
+				len: StreamLen64,
+			},
+			{
+				name:  "delta 64",
+				write: func(i int) []byte { return writer.WriteAllDelta64(lists64[i], prev64) },
+				read: func(i int, stream []byte) (int, bool) {
+					out := make([]uint64, counts[i])
+					n := ReadAllDelta64N(counts[i], stream, out, prev64)
+					return n, reflect.DeepEqual(lists64[i], out)
+				},
+				len: StreamLen64,
+			},
+			{
+				name:  "16",
+				write: func(i int) []byte { return writer.WriteAll16(lists16[i]) },
+				read: func(i int, stream []byte) (int, bool) {
+					out := make([]uint16, counts[i])
+					n := ReadAll16N(counts[i], stream, out)
+					return n, reflect.DeepEqual(lists16[i], out)
+				},
+				len: StreamLen16,
+			},
+			{
+				name:  "delta 16",
+				write: func(i int) []byte { return writer.WriteAllDelta16(lists16[i], prev16) },
+				read: func(i int, stream []byte) (int, bool) {
+					out := make([]uint16, counts[i])
+					n := ReadAllDelta16N(counts[i], stream, out, prev16)
+					return n, reflect.DeepEqual(lists16[i], out)
+				},
+				len: StreamLen16,
+			},
+		}
+	)
+	for i, count := range counts {
+		lists32[i] = util.GenInt32(count)
+		lists64[i] = util.GenUint64(count)
+		lists16[i] = util.GenUint16(count)
+	}
+
+	for _, l := range layout {
+		var buf []byte
+		for i := range counts {
+			buf = append(buf, l.write(i)...)
+		}
+
+		pos := 0
+		for i, count := range counts {
+			n, ok := l.read(i, buf[pos:])
+			if !ok {
+				t.Fatalf("%s: %d: decoded wrong nums", l.name, count)
+			}
+			if size := l.len(count, buf[pos:]); size != n {
+				t.Fatalf("%s: %d: expected %d bytes, stream len %d", l.name, count, n, size)
+			}
+			pos += n
+		}
+
+		if pos != len(buf) {
+			t.Fatalf("%s: expected %d bytes consumed, actual %d", l.name, len(buf), pos)
+		}
+	}
+}
+
+func TestReadAllSplit(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 1
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		prev := util.RandUint32()
+		t.Run(fmt.Sprintf("ReadAllSplit: %d", count), func(t *testing.T) {
+			ctrl, data := writer.WriteAllSplit(nums)
+			out := make([]uint32, count)
+			ReadAllSplit(count, ctrl, data, out)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			// Decode from a group boundary using the control bytes alone to
+			// locate its data bytes.
+			start := rand.Intn(count) &^ 3
+			if _, expected := writer.WriteAllSplit(nums[:start]); DataLen(start, ctrl) != len(expected) {
+				t.Fatalf("expected %d data bytes, actual %d", len(expected), DataLen(start, ctrl))
+			}
+			out = make([]uint32, count-start)
+			ReadAllSplit(count-start, ctrl[start/4:], data[DataLen(start, ctrl):], out)
+			if !reflect.DeepEqual(nums[start:], out) {
+				t.Fatalf("decoded wrong nums from %d", start)
+			}
+
+			ctrl, data = writer.WriteAllDeltaSplit(nums, prev)
+			out = make([]uint32, count)
+			ReadAllDeltaSplit(count, ctrl, data, out, prev)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong delta nums")
+			}
+		})
+	}
+}
+
+func TestReadAllTrailer(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		prev := util.RandUint32()
+		t.Run(fmt.Sprintf("ReadAllTrailer: %d", count), func(t *testing.T) {
+			for _, stream := range [][]byte{
+				writer.WriteAllTrailer(nums),
+				writer.WriteAllDeltaTrailer(nums, prev),
+			} {
+				out := make([]uint32, count)
+				if n, err := ReadAllTrailer(stream, out); err != nil || n != count {
+					t.Fatalf("expected %d integers, got %d: %v", count, n, err)
+				}
+				if !reflect.DeepEqual(nums, out) {
+					t.Fatalf("decoded wrong nums")
+				}
+
+				if count > 0 {
+					if _, err := ReadAllTrailer(stream, out[1:]); !errors.Is(err, ErrShortOutput) {
+						t.Fatalf("expected %v, got %v", ErrShortOutput, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestParseTrailer(t *testing.T) {
+	stream := writer.WriteAllDeltaTrailer([]uint32{2, 300, 70000, 1 << 30, 1<<30 + 5}, 1)
+	tr, err := ParseTrailer(stream)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if tr.Count != 5 || !tr.Delta || tr.Prev != 1 || len(tr.Ctrl) != 2 || len(tr.Data) != 11 {
+		t.Fatalf("bad trailer %+v", tr)
+	}
+
+	corrupt := map[string]func(stream []byte) []byte{
+		"missing footer": func(stream []byte) []byte { return stream[:shared.TrailerFooterLen-1] },
+		"truncated":      func(stream []byte) []byte { return stream[1:] },
+		"bad magic":      func(stream []byte) []byte { stream[len(stream)-1]++; return stream },
+		"unknown flags":  func(stream []byte) []byte { stream[len(stream)-4] |= 2; return stream },
+		"invalid count":  func(stream []byte) []byte { stream[len(stream)-9]++; return stream },
+		"bad control":    func(stream []byte) []byte { stream[12] ^= 0x03; return stream },
+	}
+	for name, fn := range corrupt {
+		if _, err := ParseTrailer(fn(append([]byte{}, stream...))); !errors.Is(err, ErrCorruptTrailer) {
+			t.Fatalf("%s: expected %v, got %v", name, ErrCorruptTrailer, err)
+		}
+	}
+}
+
+func TestReadAllInt32(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenInt32(count)
+		stream := writer.WriteAllInt32(nums)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]int32, count)
+			ReadAllInt32(count, stream, out)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			out = make([]int32, count)
+			if n, err := ReadAllInt32Checked(count, stream, out); n != count || err != nil {
+				t.Fatalf("failed to read: %d %v", n, err)
+			}
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			if count > 0 {
+				if _, err := ReadAllInt32Checked(count, stream[:len(stream)-1], out); !errors.Is(err, ErrTruncated) {
+					t.Fatalf("expected truncated error, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestReadAll64(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint64(count)
+		stream := writer.WriteAll64(nums)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]uint64, count)
+			ReadAll64(count, stream, out)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
+func TestReadAllDelta64(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint64(count)
+		util.SortUint64(nums)
+		stream := writer.WriteAllDelta64(nums, 7)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]uint64, count)
+			ReadAllDelta64(count, stream, out, 7)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
+func TestReadAll16(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint16(count)
+		stream := writer.WriteAll16(nums)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]uint16, count)
+			ReadAll16(count, stream, out)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
+func TestReadAllDelta16(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint16(count)
+		util.SortUint16(nums)
+		stream := writer.WriteAllDelta16(nums, 0)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]uint16, count)
+			ReadAllDelta16(count, stream, out, 0)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
+func TestReadAllVariant(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint32(count)
+		for i := range nums {
+			if rand.Intn(2) == 0 {
+				nums[i] = 0
+			}
+		}
+
+		stream := writer.WriteAllVariant(nums, shared.Variant0124)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]uint32, count)
+			ReadAllVariant(count, stream, out, shared.Variant0124)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
+// genSparse returns count integers of which roughly 1 in 20 is non zero,
+// which is what the "0124" variant is meant for.
+func genSparse(count int) []uint32 {
+	nums := make([]uint32, count)
+	for i := range nums {
+		if rand.Intn(20) == 0 {
+			nums[i] = util.RandUint32() >> rand.Intn(32)
+		}
+	}
+	return nums
+}
+
+func TestReadAllVariantSparse(t *testing.T) {
+	calls := 0
+	get8 := func(in []byte, out []uint32, ctrl uint16) {
+		calls++
+		decode.GetUint32Scalar0124(in, out, uint8(ctrl), 4)
+		decode.GetUint32Scalar0124(in[shared.ControlByteToSize0124(uint8(ctrl)):], out[4:], uint8(ctrl>>8), 4)
+	}
+
+	k := shared.Kernel{Op: decode.OpGet8uint32Variant0124, Name: "test", Tier: decode.GetTier(), Func: get8}
+	if err := shared.Register(k); err != nil {
+		t.Fatalf("unexpected error registering %s: %v", k.Op, err)
+	}
+	defer shared.Unregister(k.Op, k.Name)
+
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 64
+		nums := genSparse(count)
+		prev := util.RandUint32()
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			stream := writer.WriteAllVariant(nums, shared.Variant0124)
+			ctrls := stream[:(count+3)/4]
+
+			calls = 0
+			out := make([]uint32, count)
+			ReadAllVariant(count, stream, out, shared.Variant0124)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			// Every batch of 8 holding a non zero integer goes through the
+			// kernel, except for those within the last 24 data bytes.
+			batches := 0
+			for pos := 0; pos+8 <= count; pos += 8 {
+				if ctrls[pos/4] != 0 || ctrls[pos/4+1] != 0 {
+					batches++
+				}
+			}
+			if calls > batches || calls < batches-24 {
+				t.Fatalf("expected about %d kernel calls, got %d", batches, calls)
+			}
+
+			stream = writer.WriteAllDeltaVariant(nums, prev, shared.Variant0124)
+			out = make([]uint32, count)
+			ReadAllDeltaVariant(count, stream, out, prev, shared.Variant0124)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong delta nums")
+			}
+		})
+	}
+}
+
+func TestReadAllDeltaVariant(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		stream := writer.WriteAllDeltaVariant(nums, 0, shared.Variant0124)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]uint32, count)
+			ReadAllDeltaVariant(count, stream, out, 0, shared.Variant0124)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			if count == 0 {
+				return
+			}
+
+			out = make([]uint32, count)
+			n, err := ReadAllDeltaVariantChecked(count, stream[:rand.Intn(len(stream))], out, 0, shared.Variant0124)
+			if !errors.Is(err, ErrTruncated) {
+				t.Fatalf("expected truncated error, got %v", err)
+			}
+			if !reflect.DeepEqual(nums[:n], out[:n]) {
+				t.Fatalf("decoded wrong prefix")
+			}
+		})
+	}
+}
+
+func TestReadAllFrom(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 1
+		nums := util.GenUint32(count)
+		stream, idx := writer.WriteAllIndexed(nums, rand.Intn(512))
+		t.Run(fmt.Sprintf("ReadAllFrom: %d", count), func(t *testing.T) {
+			if !reflect.DeepEqual(idx, shared.BuildIndex(count, stream, idx.Interval)) {
+				t.Fatalf("writer and scanned index differ")
+			}
+
+			data, err := idx.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var loaded shared.Index
+			if err := loaded.UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(idx, &loaded) {
+				t.Fatalf("index did not survive serialization")
+			}
+
+			// Offsets must start at 0 and grow by 1 to 4 bytes per integer.
+			bad := *idx
+			bad.Offsets = append([]int{}, idx.Offsets...)
+			bad.Offsets[len(bad.Offsets)-1] += 4 * bad.Interval
+			if len(bad.Offsets) == 1 {
+				bad.Offsets[0] = 1
+			}
+			data, err = bad.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := loaded.UnmarshalBinary(data); !errors.Is(err, shared.ErrCorruptIndex) {
+				t.Fatalf("expected corrupt index error, got %v", err)
+			}
+
+			huge := shared.BuildIndex(count, stream, int(^uint(0)>>1))
+			if huge.Interval != shared.MaxIndexInterval || len(huge.Offsets) != 1 {
+				t.Fatalf("expected a single checkpoint, got %d every %d", len(huge.Offsets), huge.Interval)
+			}
+
+			for j := 0; j < 100; j++ {
+				start := rand.Intn(count)
+				out := make([]uint32, rand.Intn(count-start+1))
+				ReadAllFrom(count, stream, idx, start, out)
+				if !reflect.DeepEqual(nums[start:start+len(out)], out) {
+					t.Fatalf("decoded wrong nums from %d", start)
+				}
+			}
+		})
+	}
+}
+
+func TestReadAllFromDelta(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 1
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		interval := rand.Intn(512)
+		if i%2 != 0 {
+			// Spans several of the chunks BuildIndexDelta decodes.
+			interval = rand.Intn(4 * indexChunkLen)
+		}
+		stream, idx := writer.WriteAllDeltaIndexed(nums, 0, interval)
+		t.Run(fmt.Sprintf("ReadAllFrom: %d", count), func(t *testing.T) {
+			if !reflect.DeepEqual(idx, BuildIndexDelta(count, stream, 0, idx.Interval)) {
+				t.Fatalf("writer and scanned index differ")
+			}
+			if huge := BuildIndexDelta(count, stream, 0, math.MaxInt32); len(huge.Prevs) != 1 {
+				t.Fatalf("expected a single checkpoint, got %d", len(huge.Prevs))
+			}
+
+			data, err := idx.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var loaded shared.Index
+			if err := loaded.UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(idx, &loaded) {
+				t.Fatalf("index did not survive serialization")
+			}
+			if err := loaded.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, shared.ErrCorruptIndex) {
+				t.Fatalf("expected corrupt index error, got %v", err)
+			}
+
+			for j := 0; j < 100; j++ {
+				start := rand.Intn(count)
+				out := make([]uint32, rand.Intn(count-start+1))
+				ReadAllFrom(count, stream, idx, start, out)
+				if !reflect.DeepEqual(nums[start:start+len(out)], out) {
+					t.Fatalf("decoded wrong nums from %d", start)
+				}
+			}
+		})
+	}
+}
+
+func TestGetRange(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 1
+		nums := util.GenUint32(count)
+		stream := writer.WriteAll(nums)
+		t.Run(fmt.Sprintf("GetRange: %d", count), func(t *testing.T) {
+			for k := 0; k < 100; k++ {
+				i := rand.Intn(count)
+				if actual := Get(count, stream, i); actual != nums[i] {
+					t.Fatalf("expected %d, got %d at %d", nums[i], actual, i)
+				}
+
+				j := i + rand.Intn(count-i+1)
+				out := make([]uint32, j-i)
+				GetRange(count, stream, i, j, out)
+				if !reflect.DeepEqual(nums[i:j], out) {
+					t.Fatalf("decoded wrong nums from %d to %d", i, j)
+				}
+			}
+		})
+	}
+}
+
+func TestGetRangeDelta(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 1
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		stream, idx := writer.WriteAllDeltaIndexed(nums, 0, rand.Intn(512))
+		t.Run(fmt.Sprintf("GetRange: %d", count), func(t *testing.T) {
+			for k := 0; k < 100; k++ {
+				x := idx
+				if k&1 == 0 {
+					x = nil
+				}
+
+				i := rand.Intn(count)
+				if actual := GetDelta(count, stream, 0, x, i); actual != nums[i] {
+					t.Fatalf("expected %d, got %d at %d", nums[i], actual, i)
+				}
+
+				j := i + rand.Intn(count-i+1)
+				out := make([]uint32, j-i)
+				GetRangeDelta(count, stream, 0, x, i, j, out)
+				if !reflect.DeepEqual(nums[i:j], out) {
+					t.Fatalf("decoded wrong nums from %d to %d", i, j)
+				}
+			}
+		})
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		stream := writer.WriteAll(nums)
+		t.Run(fmt.Sprintf("Aggregate: %d", count), func(t *testing.T) {
+			var (
+				sum      uint64
+				min, max uint32
+				xor      uint32
+			)
+			for i, num := range nums {
+				sum += uint64(num)
+				xor ^= num
+				if i == 0 || num < min {
+					min = num
+				}
+				if num > max {
+					max = num
+				}
+			}
+
+			if actual := Sum(count, stream); actual != sum {
+				t.Fatalf("expected sum %d, got %d", sum, actual)
+			}
+			if actual := Min(count, stream); actual != min {
+				t.Fatalf("expected min %d, got %d", min, actual)
+			}
+			if actual := Max(count, stream); actual != max {
+				t.Fatalf("expected max %d, got %d", max, actual)
+			}
+			if actual := Xor(count, stream); actual != xor {
+				t.Fatalf("expected xor %d, got %d", xor, actual)
+			}
+		})
+	}
+}
+
+func TestAggregateDelta(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		prev := util.RandUint32()
+		stream := writer.WriteAllDelta(nums, prev)
+		t.Run(fmt.Sprintf("Aggregate: %d", count), func(t *testing.T) {
+			var (
+				sum      uint64
+				min, max uint32
+				xor      uint32
+			)
+			for i, num := range nums {
+				sum += uint64(num)
+				xor ^= num
+				if i == 0 || num < min {
+					min = num
+				}
+				if num > max {
+					max = num
+				}
+			}
+
+			if actual := SumDelta(count, stream, prev); actual != sum {
+				t.Fatalf("expected sum %d, got %d", sum, actual)
+			}
+			if actual := MinDelta(count, stream, prev); actual != min {
+				t.Fatalf("expected min %d, got %d", min, actual)
+			}
+			if actual := MaxDelta(count, stream, prev); actual != max {
+				t.Fatalf("expected max %d, got %d", max, actual)
+			}
+			if actual := XorDelta(count, stream, prev); actual != xor {
+				t.Fatalf("expected xor %d, got %d", xor, actual)
+			}
+		})
+	}
+}
+
+func TestSeekGE(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		for i := 1; i < count; i += 2 {
+			nums[i] = nums[i-1]
+		}
+		util.SortUint32(nums)
+		stream, idx := writer.WriteAllDeltaIndexed(nums, 0, rand.Intn(512))
+		t.Run(fmt.Sprintf("SeekGE: %d", count), func(t *testing.T) {
+			for k := 0; k < 200; k++ {
+				x := idx
+				if k&1 == 0 {
+					x = nil
+				}
+
+				target := util.RandUint32()
+				if count > 0 && k&2 == 0 {
+					target = nums[rand.Intn(count)]
+				}
+
+				expected := sort.Search(count, func(i int) bool { return nums[i] >= target })
+				pos, value, ok := SeekGE(count, stream, 0, x, target)
+				if pos != expected || ok != (expected < count) || (ok && value != nums[pos]) {
+					t.Fatalf("expected %d, got %d, %d, %v for %d", expected, pos, value, ok, target)
+				}
+
+				if actual := Rank(count, stream, 0, x, target); actual != expected {
+					t.Fatalf("expected rank %d, got %d for %d", expected, actual, target)
+				}
+
+				contains := expected < count && nums[expected] == target
+				if actual := Contains(count, stream, 0, x, target); actual != contains {
+					t.Fatalf("expected contains %v, got %v for %d", contains, actual, target)
+				}
+			}
+		})
+	}
+}
+
+func TestReadAllParallel(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint32(count)
+		prev := util.RandUint32()
+		workers := rand.Intn(8)
+		t.Run(fmt.Sprintf("ReadAllParallel: %d, %d workers", count, workers), func(t *testing.T) {
+			out := make([]uint32, count)
+			ReadAllParallel(count, writer.WriteAll(nums), out, workers)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			out = make([]uint32, count)
+			ReadAllDeltaParallel(count, writer.WriteAllDelta(nums, prev), out, prev, workers)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong delta nums")
+			}
+		})
+	}
+}
+
+func TestReadAllChecked(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 1
+		nums := util.GenUint32(count)
+		stream := writer.WriteAllScalar(nums)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]uint32, count)
+			n, err := ReadAllChecked(count, stream, out)
+			if err != nil || n != count {
+				t.Fatalf("expected %d, nil, got %d, %v", count, n, err)
+			}
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			cut := rand.Intn(len(stream))
+			out = make([]uint32, count)
+			n, err = ReadAllChecked(count, stream[:cut], out)
+			if !errors.Is(err, ErrTruncated) {
+				t.Fatalf("expected truncated error, got %v", err)
+			}
+			if !reflect.DeepEqual(nums[:n], out[:n]) {
+				t.Fatalf("decoded wrong prefix")
+			}
+		})
+	}
+
+	var (
+		out    = make([]uint32, 8)
+		maxInt = int(^uint(0) >> 1)
+	)
+	if n, err := ReadAllChecked(maxInt, []byte{1, 2, 3}, out); n != 0 || !errors.Is(err, ErrTruncated) {
+		t.Fatalf("expected 0, truncated error, got %d, %v", n, err)
+	}
+	if n, err := ReadAllDeltaChecked(maxInt, []byte{1, 2, 3}, out, 0); n != 0 || !errors.Is(err, ErrTruncated) {
+		t.Fatalf("expected 0, truncated error, got %d, %v", n, err)
+	}
+}
+
+func TestReadAllDeltaChecked(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 1
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		stream := writer.WriteAllDeltaScalar(nums, 0)
+		t.Run(fmt.Sprintf("ReadAll: %d", count), func(t *testing.T) {
+			out := make([]uint32, count)
+			n, err := ReadAllDeltaChecked(count, stream, out, 0)
+			if err != nil || n != count {
+				t.Fatalf("expected %d, nil, got %d, %v", count, n, err)
+			}
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			out = make([]uint32, rand.Intn(count))
+			n, err = ReadAllDeltaChecked(count, stream, out, 0)
+			if !errors.Is(err, ErrShortOutput) {
+				t.Fatalf("expected short output error, got %v", err)
+			}
+			if !reflect.DeepEqual(nums[:n], out[:n]) {
+				t.Fatalf("decoded wrong prefix")
+			}
+		})
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		buf := &bytes.Buffer{}
+		enc := writer.NewEncoder(buf, writer.EncoderOptions{
+			BlockLen: rand.Intn(1024),
+			Delta:    i%2 == 0,
+		})
+		_ = enc.Write(nums)
+		_ = enc.Close()
+		t.Run(fmt.Sprintf("Decoder: %d", count), func(t *testing.T) {
+			var src io.Reader = bytes.NewReader(buf.Bytes())
+			if i%3 == 0 {
+				// Hide the io.ByteReader implementation.
+				src = struct{ io.Reader }{src}
+			}
+
+			dec := NewDecoder(src)
+			actual := make([]uint32, 0, count)
+			for {
+				dst := make([]uint32, rand.Intn(512)+1)
+				n, err := dec.Read(dst)
+				actual = append(actual, dst[:n]...)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("failed to read: %v", err)
+				}
+			}
+
+			if len(actual) != count || (count > 0 && !reflect.DeepEqual(nums, actual)) {
+				t.Fatalf("decoded wrong nums")
+			}
+
+			if count == 0 {
+				return
+			}
+
+			dec = NewDecoder(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+			var err error
+			for err == nil {
+				_, err = dec.Read(make([]uint32, count))
+			}
+			if err != io.ErrUnexpectedEOF {
+				t.Fatalf("expected unexpected EOF, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDecoderPrev(t *testing.T) {
+	// A delta stream whose prev of 2^32 doesn't fit into a uint32.
+	frame := []byte{shared.FrameFlagDelta, 0x80, 0x80, 0x80, 0x80, 0x10, 1, 2, 0x00, 5}
+	_, err := NewDecoder(bytes.NewReader(frame)).Read(make([]uint32, 1))
+	if !errors.Is(err, ErrCorruptFrame) {
+		t.Fatalf("expected corrupt frame error, got %v", err)
+	}
+
+	frame = []byte{shared.FrameFlagDelta, 0xff, 0xff, 0xff, 0xff, 0x0f, 1, 2, 0x00, 5}
+	out := make([]uint32, 1)
+	if n, err := NewDecoder(bytes.NewReader(frame)).Read(out); n != 1 || err != nil || out[0] != 4 {
+		t.Fatalf("failed to read valid frame: %d %v %v", n, out, err)
+	}
+}
+
+func TestDecoderBlockLen(t *testing.T) {
+	// A single block of the integer 5 whose length claims a trailing byte.
+	frame := []byte{0, 0, 1, 3, 0x00, 5, 0}
+	_, err := NewDecoder(bytes.NewReader(frame)).Read(make([]uint32, 1))
+	if !errors.Is(err, ErrCorruptFrame) {
+		t.Fatalf("expected corrupt frame error, got %v", err)
+	}
+
+	frame = []byte{0, 0, 1, 2, 0x00, 5}
+	out := make([]uint32, 1)
+	if n, err := NewDecoder(bytes.NewReader(frame)).Read(out); n != 1 || err != nil || out[0] != 5 {
+		t.Fatalf("failed to read valid frame: %d %v %v", n, out, err)
+	}
+}
+
 var readSinkA []uint32
 
 func BenchmarkReadAllFast(b *testing.B) {
@@ -180,6 +1273,30 @@ func BenchmarkReadAllDeltaScalar(b *testing.B) {
 	}
 }
 
+var readSinkVariant []uint32
+
+func BenchmarkReadAllVariantSparse(b *testing.B) {
+	defer decode.SetTier(decode.SupportedTier())
+
+	count := int(1e6)
+	nums := genSparse(count)
+	stream := writer.WriteAllVariant(nums, shared.Variant0124)
+	out := make([]uint32, count)
+	for tier := shared.TierScalar; tier <= decode.SupportedTier(); tier++ {
+		b.Run(tier.String(), func(b *testing.B) {
+			if err := decode.SetTier(tier); err != nil {
+				b.Fatal(err)
+			}
+			b.SetBytes(int64(count * encode.MaxBytesPerNum))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				ReadAllVariant(count, stream, out, shared.Variant0124)
+			}
+			readSinkVariant = out
+		})
+	}
+}
+
 var readSinkE []uint32
 
 func BenchmarkReadAllVarint(b *testing.B) {
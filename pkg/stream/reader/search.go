@@ -0,0 +1,90 @@
+package reader
+
+import (
+	"sort"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// The search functions below operate on differentially coded streams of
+// sorted integers, e.g. as produced by writer.WriteAllDelta. When a skip
+// index built over the stream is provided, its checkpoints are used to
+// skip over every block whose last integer is below the target, so that
+// only the candidate block is decoded. Otherwise the stream is decoded from
+// its start until the target is reached. Like with ReadAllFrom, the index
+// must be trusted as its offsets are not checked against the stream.
+
+// SeekGE returns the position and value of the first integer of the sorted,
+// differentially coded stream holding count integers that is greater than
+// or equal to target. If there is none, it returns count, 0 and false.
+// The index idx is optional.
+func SeekGE(count int, stream []byte, prev uint32, idx *shared.Index, target uint32) (int, uint32, bool) {
+	var (
+		ctrls  = stream[:(count+3)/4]
+		data   = stream[len(ctrls):]
+		pos    = 0
+		offset = 0
+	)
+
+	// The prev of every checkpoint but the first is the last integer of
+	// the preceding block, so the candidate block precedes the first
+	// checkpoint whose prev is not below target.
+	if idx != nil && len(idx.Prevs) > 0 {
+		k := sort.Search(len(idx.Prevs)-1, func(k int) bool {
+			return idx.Prevs[k+1] >= target
+		})
+		pos, offset, prev = k*idx.Interval, idx.Offsets[k], idx.Prevs[k]
+	}
+
+	return seekGE(count, ctrls, data, pos, offset, prev, target)
+}
+
+// Contains reports whether x is one of the integers of the sorted,
+// differentially coded stream holding count integers. The index idx is
+// optional.
+func Contains(count int, stream []byte, prev uint32, idx *shared.Index, x uint32) bool {
+	_, value, ok := SeekGE(count, stream, prev, idx, x)
+	return ok && value == x
+}
+
+// Rank returns the count of integers of the sorted, differentially coded
+// stream holding count integers that are less than x. The index idx is
+// optional.
+func Rank(count int, stream []byte, prev uint32, idx *shared.Index, x uint32) int {
+	pos, _, _ := SeekGE(count, stream, prev, idx, x)
+	return pos
+}
+
+// seekGE decodes the stream 8 integers at a time starting with the group at
+// position pos, whose data starts at offset, until it finds an integer that
+// is greater than or equal to target. Batches whose last integer is below
+// target are skipped without looking at the others.
+func seekGE(count int, ctrls, data []byte, pos, offset int, prev uint32, target uint32) (int, uint32, bool) {
+	var nums [8]uint32
+	for pos < count {
+		n := 8
+		// The kernels load 16 bytes for each half of the batch, so they
+		// are only used while that many bytes are left in data.
+		if count-pos >= 8 && len(data)-offset >= 32 {
+			ctrl := uint16(ctrls[pos/4]) | uint16(ctrls[pos/4+1])<<8
+			decode.Get8uint32Delta(data[offset:], nums[:], ctrl, prev)
+			offset += shared.ControlByteToSizeTwo(ctrl)
+		} else {
+			n = groupLen(count, pos)
+			offset += decode.GetUint32DeltaScalar(data[offset:], nums[:], ctrls[pos/4], n, prev)
+		}
+
+		prev = nums[n-1]
+		if prev >= target {
+			for i, num := range nums[:n] {
+				if num >= target {
+					return pos + i, num, true
+				}
+			}
+		}
+		pos += n
+	}
+
+	return count, 0, false
+}
@@ -0,0 +1,22 @@
+package reader
+
+import "github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+
+// ReadAllSplit works similarly to ReadAll except that the control bytes
+// and the data bytes are provided separately, e.g. as written by
+// writer.WriteAllSplit. The data bytes of the integers from i on start at
+// DataLen(i, ctrl), which only requires the control bytes.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAllSplit(count int, ctrl, data []byte, out []uint32) {
+	readAllTier(decode.GetTier(), count, ctrl[:(count+3)/4], data, out)
+}
+
+// ReadAllDeltaSplit works similarly to ReadAllDelta except that the control
+// bytes and the data bytes are provided separately the same way as
+// ReadAllSplit.
+func ReadAllDeltaSplit(count int, ctrl, data []byte, out []uint32, prev uint32) {
+	readAllDeltaTier(decode.GetTier(), count, ctrl[:(count+3)/4], data, out, prev)
+}
@@ -0,0 +1,90 @@
+package reader
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// Trailer describes a stream in the trailer format described in the shared
+// package, as located from its footer.
+type Trailer struct {
+	// Count is the count of integers in the stream.
+	Count int
+	// Delta reports whether the integers are differentially coded
+	// starting from Prev.
+	Delta bool
+	Prev  uint32
+	// Ctrl and Data hold the control bytes and the data bytes of the
+	// stream.
+	Ctrl, Data []byte
+}
+
+// ParseTrailer locates the control bytes and the data bytes of stream, in
+// the trailer format, from its footer. It makes sure that the data bytes
+// described by the control bytes exactly fill the rest of stream, thus
+// the returned Trailer is safe to decode even if stream is untrusted.
+func ParseTrailer(stream []byte) (Trailer, error) {
+	if len(stream) < shared.TrailerFooterLen {
+		return Trailer{}, fmt.Errorf("%w: missing footer", ErrCorruptTrailer)
+	}
+
+	body := stream[:len(stream)-shared.TrailerFooterLen]
+	footer := stream[len(body):]
+	if string(footer[13:]) != shared.TrailerMagic {
+		return Trailer{}, fmt.Errorf("%w: bad magic %q", ErrCorruptTrailer, footer[13:])
+	}
+
+	flags := footer[12]
+	if flags&^shared.TrailerFlagDelta != 0 {
+		return Trailer{}, fmt.Errorf("%w: unknown flags %#02x", ErrCorruptTrailer, flags)
+	}
+
+	// Every integer takes up at least one data byte.
+	count := binary.LittleEndian.Uint64(footer)
+	if count > uint64(len(body)) {
+		return Trailer{}, fmt.Errorf("%w: invalid count %d", ErrCorruptTrailer, count)
+	}
+
+	var (
+		ctrlLen = (int(count) + 3) / 4
+		ctrl    = body[len(body)-ctrlLen:]
+		data    = body[:len(body)-ctrlLen]
+	)
+	if size := DataLen(int(count), ctrl); size != len(data) {
+		return Trailer{}, fmt.Errorf("%w: expected %d data bytes, actual %d", ErrCorruptTrailer, size, len(data))
+	}
+
+	return Trailer{
+		Count: int(count),
+		Delta: flags&shared.TrailerFlagDelta != 0,
+		Prev:  binary.LittleEndian.Uint32(footer[8:]),
+		Ctrl:  ctrl,
+		Data:  data,
+	}, nil
+}
+
+// ReadAllTrailer will read the entire input stream, in the trailer format,
+// into out. The stream is validated by ParseTrailer first, so it is safe to
+// use on untrusted input. Differentially coded streams are reconstructed
+// using the prev stored in the footer. It will select the best
+// implementation depending on the presence of special hardware
+// instructions. Returns the number of integers written to out.
+func ReadAllTrailer(stream []byte, out []uint32) (int, error) {
+	tr, err := ParseTrailer(stream)
+	if err != nil {
+		return 0, err
+	}
+	if len(out) < tr.Count {
+		return 0, fmt.Errorf("%w: %d integers", ErrShortOutput, tr.Count)
+	}
+
+	if tr.Delta {
+		readAllDeltaTier(decode.GetTier(), tr.Count, tr.Ctrl, tr.Data, out, tr.Prev)
+	} else {
+		readAllTier(decode.GetTier(), tr.Count, tr.Ctrl, tr.Data, out)
+	}
+	return tr.Count, nil
+}
@@ -0,0 +1,102 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// maxGroupLen16 is the largest number of data bytes a batch of 8 uint16s
+// can occupy. The kernels may read up to that many bytes regardless of the
+// actual size of the batch.
+const maxGroupLen16 = 8 * encode.MaxBytesPerNum16
+
+// ReadAll16 will read the entire input stream into out according to the
+// 16-bit variant of Stream VByte. It will select the best implementation
+// depending on the presence of special hardware instructions.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAll16(count int, stream []byte, out []uint16) {
+	ReadAll16N(count, stream, out)
+}
+
+// ReadAll16N works similarly to ReadAll16 except that it returns the number
+// of bytes of stream consumed.
+func ReadAll16N(count int, stream []byte, out []uint16) int {
+	var (
+		ctrlPos = 0
+		decoded = 0
+		dataPos = (count + 7) / 8
+	)
+
+	for ; count-decoded >= 8 && len(stream)-dataPos >= maxGroupLen16; ctrlPos += 1 {
+		decode.Get8uint16(stream[dataPos:], out[decoded:], stream[ctrlPos])
+		dataPos += shared.ControlByteToSize16(stream[ctrlPos])
+		decoded += 8
+	}
+
+	for ; decoded < count; ctrlPos += 1 {
+		nums := count - decoded
+		if nums > 8 {
+			nums = 8
+		}
+		dataPos += decode.GetUint16Scalar(
+			stream[dataPos:],
+			out[decoded:],
+			stream[ctrlPos],
+			nums,
+		)
+		decoded += nums
+	}
+
+	return dataPos
+}
+
+// ReadAllDelta16 will read the entire input stream into out according to
+// the 16-bit variant of Stream VByte and reconstruct the original non
+// differentially encoded values. It will select the best implementation
+// depending on the presence of special hardware instructions.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAllDelta16(count int, stream []byte, out []uint16, prev uint16) {
+	ReadAllDelta16N(count, stream, out, prev)
+}
+
+// ReadAllDelta16N works similarly to ReadAllDelta16 except that it returns
+// the number of bytes of stream consumed.
+func ReadAllDelta16N(count int, stream []byte, out []uint16, prev uint16) int {
+	var (
+		ctrlPos = 0
+		decoded = 0
+		dataPos = (count + 7) / 8
+	)
+
+	for ; count-decoded >= 8 && len(stream)-dataPos >= maxGroupLen16; ctrlPos += 1 {
+		decode.Get8uint16Delta(stream[dataPos:], out[decoded:], stream[ctrlPos], prev)
+		dataPos += shared.ControlByteToSize16(stream[ctrlPos])
+		prev = out[decoded+7]
+		decoded += 8
+	}
+
+	for ; decoded < count; ctrlPos += 1 {
+		nums := count - decoded
+		if nums > 8 {
+			nums = 8
+		}
+		dataPos += decode.GetUint16DeltaScalar(
+			stream[dataPos:],
+			out[decoded:],
+			stream[ctrlPos],
+			nums,
+			prev,
+		)
+		decoded += nums
+		prev = out[decoded-1]
+	}
+
+	return dataPos
+}
@@ -0,0 +1,106 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// maxGroupLen64 is the largest number of data bytes a batch of 8 uint64s
+// can occupy. The kernels may read up to that many bytes regardless of the
+// actual size of the batch.
+const maxGroupLen64 = 8 * encode.MaxBytesPerNum64
+
+// ReadAll64 will read the entire input stream into out according to the
+// 64-bit variant of Stream VByte. It will select the best implementation
+// depending on the presence of special hardware instructions.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAll64(count int, stream []byte, out []uint64) {
+	ReadAll64N(count, stream, out)
+}
+
+// ReadAll64N works similarly to ReadAll64 except that it returns the number
+// of bytes of stream consumed.
+func ReadAll64N(count int, stream []byte, out []uint64) int {
+	var (
+		ctrlPos = 0
+		decoded = 0
+		dataPos = (count + 3) / 4
+		ctrlLen = dataPos
+	)
+
+	for ; count-decoded >= 8 && len(stream)-dataPos >= maxGroupLen64; ctrlPos += 2 {
+		ctrl := uint16(stream[ctrlPos]) | uint16(stream[ctrlPos+1])<<8
+		decode.Get8uint64(stream[dataPos:], out[decoded:], ctrl)
+		dataPos += shared.ControlByteToSizeTwo64(ctrl)
+		decoded += 8
+	}
+
+	for ; ctrlPos < ctrlLen; ctrlPos += 1 {
+		nums := count - decoded
+		if nums > 4 {
+			nums = 4
+		}
+		dataPos += decode.GetUint64Scalar(
+			stream[dataPos:],
+			out[decoded:],
+			stream[ctrlPos],
+			nums,
+		)
+		decoded += nums
+	}
+
+	return dataPos
+}
+
+// ReadAllDelta64 will read the entire input stream into out according to
+// the 64-bit variant of Stream VByte and reconstruct the original non
+// differentially encoded values. It will select the best implementation
+// depending on the presence of special hardware instructions.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAllDelta64(count int, stream []byte, out []uint64, prev uint64) {
+	ReadAllDelta64N(count, stream, out, prev)
+}
+
+// ReadAllDelta64N works similarly to ReadAllDelta64 except that it returns
+// the number of bytes of stream consumed.
+func ReadAllDelta64N(count int, stream []byte, out []uint64, prev uint64) int {
+	var (
+		ctrlPos = 0
+		decoded = 0
+		dataPos = (count + 3) / 4
+		ctrlLen = dataPos
+	)
+
+	for ; count-decoded >= 8 && len(stream)-dataPos >= maxGroupLen64; ctrlPos += 2 {
+		ctrl := uint16(stream[ctrlPos]) | uint16(stream[ctrlPos+1])<<8
+		decode.Get8uint64Delta(stream[dataPos:], out[decoded:], ctrl, prev)
+		dataPos += shared.ControlByteToSizeTwo64(ctrl)
+		prev = out[decoded+7]
+		decoded += 8
+	}
+
+	for ; ctrlPos < ctrlLen; ctrlPos += 1 {
+		nums := count - decoded
+		if nums > 4 {
+			nums = 4
+		}
+		dataPos += decode.GetUint64DeltaScalar(
+			stream[dataPos:],
+			out[decoded:],
+			stream[ctrlPos],
+			nums,
+			prev,
+		)
+		decoded += nums
+		prev = out[decoded-1]
+	}
+
+	return dataPos
+}
@@ -0,0 +1,144 @@
+package reader
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/decode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// ReadAllVariant will read the entire input stream into out according to
+// the provided variant of Stream VByte. The "0124" variant is byte
+// compatible with the streamvbyte_decode_0124 func of the reference C
+// implementation. It will select the best implementation depending on the
+// presence of special hardware instructions.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAllVariant(count int, stream []byte, out []uint32, v shared.Variant) {
+	if v != shared.Variant0124 {
+		ReadAll(count, stream, out)
+		return
+	}
+
+	ctrlLen := (count + 3) / 4
+	readAll0124(count, stream[:ctrlLen], stream[ctrlLen:], out)
+}
+
+// ReadAllDeltaVariant will read the entire input stream into out according
+// to the provided variant of Stream VByte and reconstruct the original non
+// differentially encoded values. It will select the best implementation
+// depending on the presence of special hardware instructions.
+//
+// Note: It is your responsibility to ensure that the incoming slices are
+// appropriately sized as well as tracking the count of integers in the
+// stream.
+func ReadAllDeltaVariant(count int, stream []byte, out []uint32, prev uint32, v shared.Variant) {
+	if v != shared.Variant0124 {
+		ReadAllDelta(count, stream, out, prev)
+		return
+	}
+
+	ctrlLen := (count + 3) / 4
+	readAllDelta0124(count, stream[:ctrlLen], stream[ctrlLen:], out, prev)
+}
+
+// readAll0124 decodes count integers of the "0124" variant described by
+// ctrls from data into out. Since integers may take up no data bytes at
+// all, batches of 8 zeros are written out directly and the kernels are
+// only used while their loads stay within data, see safeLoad0124. Returns
+// the number of data bytes read.
+func readAll0124(count int, ctrls, data []byte, out []uint32) int {
+	var (
+		ctrlPos = 0
+		decoded = 0
+		dataPos = 0
+	)
+
+	for ; count-decoded >= 8; ctrlPos += 2 {
+		ctrl := uint16(ctrls[ctrlPos]) | uint16(ctrls[ctrlPos+1])<<8
+		switch {
+		case ctrl == 0:
+			nums := out[decoded : decoded+8]
+			for i := range nums {
+				nums[i] = 0
+			}
+		case safeLoad0124(data, dataPos, ctrls[ctrlPos]):
+			decode.Get8uint32Variant(data[dataPos:], out[decoded:], ctrl, shared.Variant0124)
+		default:
+			size := decode.GetUint32Scalar0124(data[dataPos:], out[decoded:], ctrls[ctrlPos], 4)
+			decode.GetUint32Scalar0124(data[dataPos+size:], out[decoded+4:], ctrls[ctrlPos+1], 4)
+		}
+		dataPos += shared.ControlByteToSizeTwo0124(ctrl)
+		decoded += 8
+	}
+
+	for ; decoded < count; ctrlPos += 1 {
+		nums := count - decoded
+		if nums > 4 {
+			nums = 4
+		}
+		dataPos += decode.GetUint32Scalar0124(
+			data[dataPos:],
+			out[decoded:],
+			ctrls[ctrlPos],
+			nums,
+		)
+		decoded += nums
+	}
+
+	return dataPos
+}
+
+// readAllDelta0124 works similarly to readAll0124 except that it
+// reconstructs the original non differentially encoded values.
+func readAllDelta0124(count int, ctrls, data []byte, out []uint32, prev uint32) int {
+	var (
+		ctrlPos = 0
+		decoded = 0
+		dataPos = 0
+	)
+
+	for ; count-decoded >= 8; ctrlPos += 2 {
+		ctrl := uint16(ctrls[ctrlPos]) | uint16(ctrls[ctrlPos+1])<<8
+		switch {
+		case ctrl == 0:
+			nums := out[decoded : decoded+8]
+			for i := range nums {
+				nums[i] = prev
+			}
+		case safeLoad0124(data, dataPos, ctrls[ctrlPos]):
+			decode.Get8uint32DeltaVariant(data[dataPos:], out[decoded:], ctrl, prev, shared.Variant0124)
+		default:
+			size := decode.GetUint32DeltaScalar0124(data[dataPos:], out[decoded:], ctrls[ctrlPos], 4, prev)
+			decode.GetUint32DeltaScalar0124(data[dataPos+size:], out[decoded+4:], ctrls[ctrlPos+1], 4, out[decoded+3])
+		}
+		dataPos += shared.ControlByteToSizeTwo0124(ctrl)
+		prev = out[decoded+7]
+		decoded += 8
+	}
+
+	for ; decoded < count; ctrlPos += 1 {
+		nums := count - decoded
+		if nums > 4 {
+			nums = 4
+		}
+		dataPos += decode.GetUint32DeltaScalar0124(
+			data[dataPos:],
+			out[decoded:],
+			ctrls[ctrlPos],
+			nums,
+			prev,
+		)
+		decoded += nums
+		prev = out[decoded-1]
+	}
+
+	return dataPos
+}
+
+// safeLoad0124 works similarly to safeLoad for the "0124" variant, where
+// the loads are bounded by the data bytes the group actually takes up
+// rather than by its worst case size.
+func safeLoad0124(data []byte, dataPos int, ctrl uint8) bool {
+	return dataPos+shared.ControlByteToSize0124(ctrl)+16 <= len(data)
+}
@@ -0,0 +1,53 @@
+package writer
+
+import "github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+
+// AppendAll appends the Stream VByte encoding of in to dst and returns the
+// extended buffer. Similar to append, dst is only reallocated when its spare
+// capacity cannot hold the worst case encoding of in, i.e. MaxEncodedLen.
+// It will select the best implementation depending on the presence of
+// special hardware instructions.
+func AppendAll(dst []byte, in []uint32) []byte {
+	var (
+		start   = len(dst)
+		ctrlLen = (len(in) + 3) / 4
+		written int
+	)
+
+	dst = grow(dst, MaxEncodedLen(len(in)))
+	ctrls, data := dst[start:start+ctrlLen], dst[start+ctrlLen:]
+	written = writeAllTier(encode.GetTier(), in, ctrls, data)
+
+	return dst[:start+ctrlLen+written]
+}
+
+// AppendAllDelta appends the differential Stream VByte encoding of in to dst
+// and returns the extended buffer. Similar to append, dst is only reallocated
+// when its spare capacity cannot hold the worst case encoding of in, i.e.
+// MaxEncodedLen. It will select the best implementation depending on the
+// presence of special hardware instructions.
+func AppendAllDelta(dst []byte, in []uint32, prev uint32) []byte {
+	var (
+		start   = len(dst)
+		ctrlLen = (len(in) + 3) / 4
+		written int
+	)
+
+	dst = grow(dst, MaxEncodedLen(len(in)))
+	ctrls, data := dst[start:start+ctrlLen], dst[start+ctrlLen:]
+	written = writeAllDeltaTier(encode.GetTier(), in, ctrls, data, prev)
+
+	return dst[:start+ctrlLen+written]
+}
+
+// grow returns dst extended by n bytes. A new buffer is only allocated
+// when the spare capacity of dst is too small.
+func grow(dst []byte, n int) []byte {
+	if cap(dst)-len(dst) >= n {
+		return dst[:len(dst)+n]
+	}
+
+	grown := make([]byte, len(dst)+n, 2*cap(dst)+n)
+	copy(grown, dst)
+	return grown
+}
@@ -0,0 +1,149 @@
+package writer
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// ErrClosed is returned when writing to an Encoder that has been closed.
+var ErrClosed = errors.New("streamvbyte: encoder closed")
+
+// EncoderOptions configures an Encoder.
+type EncoderOptions struct {
+	// BlockLen is the count of integers buffered before a block is encoded
+	// and written out. Values less than 1 select shared.DefaultFrameBlockLen
+	// and values greater than shared.MaxFrameBlockLen are capped.
+	BlockLen int
+
+	// Delta enables differential coding of the written integers.
+	Delta bool
+
+	// Prev is the base value used for differentially coding the first
+	// integer written.
+	Prev uint32
+}
+
+// Encoder writes integers to an io.Writer using the framed Stream VByte
+// format described in the shared package. Integers are buffered into fixed
+// size blocks, each of which is encoded using the fastest implementation
+// available. An Encoder is not safe for concurrent use.
+type Encoder struct {
+	w       io.Writer
+	opts    EncoderOptions
+	prev    uint32
+	pending []uint32
+	frame   []byte
+	started bool
+	closed  bool
+	err     error
+}
+
+// NewEncoder returns an Encoder writing to w. Nothing is written to w until
+// the first block is full or Flush or Close are called.
+func NewEncoder(w io.Writer, opts EncoderOptions) *Encoder {
+	if opts.BlockLen < 1 {
+		opts.BlockLen = shared.DefaultFrameBlockLen
+	}
+	if opts.BlockLen > shared.MaxFrameBlockLen {
+		opts.BlockLen = shared.MaxFrameBlockLen
+	}
+
+	return &Encoder{
+		w:       w,
+		opts:    opts,
+		prev:    opts.Prev,
+		pending: make([]uint32, 0, opts.BlockLen),
+	}
+}
+
+// Write buffers vals, writing out every block that fills up. When
+// differential coding is enabled, the prev value is carried across calls.
+func (e *Encoder) Write(vals []uint32) error {
+	if e.closed {
+		return ErrClosed
+	}
+
+	for len(vals) > 0 && e.err == nil {
+		n := copy(e.pending[len(e.pending):cap(e.pending)], vals)
+		e.pending = e.pending[:len(e.pending)+n]
+		vals = vals[n:]
+		if len(e.pending) == cap(e.pending) {
+			e.writeBlock()
+		}
+	}
+
+	return e.err
+}
+
+// Flush encodes and writes out any buffered integers as a possibly partial
+// block.
+func (e *Encoder) Flush() error {
+	if e.closed {
+		return ErrClosed
+	}
+
+	if e.err == nil && (len(e.pending) > 0 || !e.started) {
+		e.writeBlock()
+	}
+	return e.err
+}
+
+// Close flushes any buffered integers. It does not close the underlying
+// io.Writer.
+func (e *Encoder) Close() error {
+	if e.closed {
+		return e.err
+	}
+
+	err := e.Flush()
+	e.closed = true
+	return err
+}
+
+// maxFrameHeaderLen is the largest number of bytes preceding the encoded
+// integers of a block, i.e. the stream header followed by the uvarint count
+// and byte length of the block.
+const maxFrameHeaderLen = 1 + 3*binary.MaxVarintLen32
+
+// writeBlock frames and writes out the pending integers, preceded by the
+// stream header when nothing has been written yet. The integers are encoded
+// first, after enough room for the headers, which are then filled in right
+// before them once the byte length of the block is known.
+func (e *Encoder) writeBlock() {
+	var (
+		header [maxFrameHeaderLen]byte
+		n      = 0
+	)
+	if !e.started {
+		if e.opts.Delta {
+			header[0] |= shared.FrameFlagDelta
+		}
+		n = 1 + binary.PutUvarint(header[1:], uint64(e.prev))
+		e.started = true
+	}
+
+	if cap(e.frame) < maxFrameHeaderLen {
+		e.frame = make([]byte, maxFrameHeaderLen)
+	}
+	frame := e.frame[:maxFrameHeaderLen]
+	if count := len(e.pending); count > 0 {
+		if e.opts.Delta {
+			frame = AppendAllDelta(frame, e.pending, e.prev)
+			e.prev = e.pending[count-1]
+		} else {
+			frame = AppendAll(frame, e.pending)
+		}
+		n += binary.PutUvarint(header[n:], uint64(count))
+		n += binary.PutUvarint(header[n:], uint64(len(frame)-maxFrameHeaderLen))
+	}
+
+	start := maxFrameHeaderLen - n
+	copy(frame[start:], header[:n])
+
+	e.frame = frame
+	e.pending = e.pending[:0]
+	_, e.err = e.w.Write(frame[start:])
+}
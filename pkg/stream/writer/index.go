@@ -0,0 +1,30 @@
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// WriteAllIndexed works similarly to WriteAll except that it also returns a
+// skip index over the stream with a checkpoint every interval integers. See
+// shared.BuildIndex for how interval is interpreted.
+func WriteAllIndexed(in []uint32, interval int) ([]byte, *shared.Index) {
+	stream := WriteAll(in)
+	return stream, shared.BuildIndex(len(in), stream, interval)
+}
+
+// WriteAllDeltaIndexed works similarly to WriteAllDelta except that it also
+// returns a skip index over the stream with a checkpoint every interval
+// integers. See shared.BuildIndex for how interval is interpreted.
+func WriteAllDeltaIndexed(in []uint32, prev uint32, interval int) ([]byte, *shared.Index) {
+	stream := WriteAllDelta(in, prev)
+	idx := shared.BuildIndex(len(in), stream, interval)
+	idx.Delta = true
+	idx.Prevs = make([]uint32, len(idx.Offsets))
+	for k := range idx.Prevs {
+		if k > 0 {
+			prev = in[k*idx.Interval-1]
+		}
+		idx.Prevs[k] = prev
+	}
+	return stream, idx
+}
@@ -0,0 +1,45 @@
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// WriteAllInt32 will zigzag encode all the integers from in and then encode
+// them using the Stream VByte format. Returns the byte array holding the
+// encoded data. It will select the best implementation depending on the
+// presence of special hardware instructions.
+func WriteAllInt32(in []int32) []byte {
+	var (
+		count   = len(in)
+		ctrlLen = (count + 3) / 4
+		stream  = make([]byte, MaxEncodedLen(count))
+
+		dataPos = ctrlLen
+		ctrlPos = 0
+		encoded = 0
+	)
+
+	for ; ctrlPos < ctrlLen-2; ctrlPos += 2 {
+		ctrl := encode.Put8int32(in[encoded:], stream[dataPos:])
+		stream[ctrlPos] = uint8(ctrl & 0xff)
+		stream[ctrlPos+1] = uint8(ctrl >> 8)
+		encoded += 8
+		dataPos += shared.ControlByteToSizeTwo(ctrl)
+	}
+
+	for ; ctrlPos < ctrlLen; ctrlPos += 1 {
+		nums := count - encoded
+		if nums > 4 {
+			nums = 4
+		}
+		ctrl := encode.PutInt32Scalar(in[encoded:], stream[dataPos:], nums)
+		size := shared.ControlByteToSize(ctrl)
+		stream[ctrlPos] = ctrl
+		size -= 4 - nums
+		dataPos += size
+		encoded += nums
+	}
+
+	return stream[:dataPos]
+}
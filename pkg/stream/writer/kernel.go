@@ -0,0 +1,67 @@
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// putKernels and putDeltaKernels hold the kernel selected at every tier so
+// that the funcs taking a tier don't take the registry lock on every call.
+var putKernels, putDeltaKernels [shared.TierAVX2 + 1]shared.Kernel
+
+func init() {
+	setImpls()
+	shared.OnReselect(setImpls)
+}
+
+// setImpls selects the kernels used at every tier according to the
+// registered kernels.
+func setImpls() {
+	for t := shared.TierScalar; t <= shared.TierAVX2; t++ {
+		putKernels[t] = shared.Select(encode.OpPut8uint32, t)
+		putDeltaKernels[t] = shared.Select(encode.OpPut8uint32Delta, t)
+	}
+}
+
+// writeAllKernel encodes in writing the control bytes into ctrls and the
+// data bytes into data, which must be able to hold the worst case encoding,
+// using the registered kernel put8 8 integers at a time. Returns the number
+// of data bytes written.
+func writeAllKernel(put8 func([]uint32, []byte) uint16, in []uint32, ctrls, data []byte) int {
+	var (
+		dataPos = 0
+		encoded = 0
+		lowest8 = len(in) &^ 7
+	)
+
+	for ; encoded < lowest8; encoded += 8 {
+		ctrl := put8(in[encoded:encoded+8], data[dataPos:])
+		ctrls[encoded/4] = uint8(ctrl)
+		ctrls[encoded/4+1] = uint8(ctrl >> 8)
+		dataPos += shared.ControlByteToSizeTwo(ctrl)
+	}
+
+	return dataPos + writeAllScalar(in[encoded:], ctrls[encoded/4:], data[dataPos:])
+}
+
+// writeAllDeltaKernel differentially encodes in writing the control bytes
+// into ctrls and the data bytes into data, which must be able to hold the
+// worst case encoding, using the registered kernel put8 8 integers at a
+// time. Returns the number of data bytes written.
+func writeAllDeltaKernel(put8 func([]uint32, []byte, uint32) uint16, in []uint32, ctrls, data []byte, prev uint32) int {
+	var (
+		dataPos = 0
+		encoded = 0
+		lowest8 = len(in) &^ 7
+	)
+
+	for ; encoded < lowest8; encoded += 8 {
+		ctrl := put8(in[encoded:encoded+8], data[dataPos:], prev)
+		ctrls[encoded/4] = uint8(ctrl)
+		ctrls[encoded/4+1] = uint8(ctrl >> 8)
+		dataPos += shared.ControlByteToSizeTwo(ctrl)
+		prev = in[encoded+7]
+	}
+
+	return dataPos + writeAllDeltaScalar(in[encoded:], ctrls[encoded/4:], data[dataPos:], prev)
+}
@@ -0,0 +1,56 @@
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// MaxEncodedLen returns the maximum number of bytes needed to encode count
+// integers using the Stream VByte format.
+func MaxEncodedLen(count int) int {
+	return (count+3)/4 + encode.MaxBytesPerNum*count
+}
+
+// EncodedLen returns the exact number of bytes WriteAll will produce when
+// encoding in, without encoding it. It will select the best implementation
+// depending on the presence of special hardware instructions.
+func EncodedLen(in []uint32) int {
+	if encode.GetMode() == shared.Fast {
+		return EncodedLenFast(in)
+	} else {
+		return EncodedLenScalar(in)
+	}
+}
+
+// EncodedLenDelta returns the exact number of bytes WriteAllDelta will
+// produce when differentially encoding in, without encoding it. It will
+// select the best implementation depending on the presence of special
+// hardware instructions.
+func EncodedLenDelta(in []uint32, prev uint32) int {
+	if encode.GetMode() == shared.Fast {
+		return EncodedLenDeltaFast(in, prev)
+	} else {
+		return EncodedLenDeltaScalar(in, prev)
+	}
+}
+
+// EncodedLenScalar returns the exact number of bytes WriteAll will produce
+// when encoding in.
+func EncodedLenScalar(in []uint32) int {
+	total := (len(in) + 3) / 4
+	for _, num := range in {
+		total += encode.SizeUint32(num)
+	}
+	return total
+}
+
+// EncodedLenDeltaScalar returns the exact number of bytes WriteAllDelta
+// will produce when differentially encoding in.
+func EncodedLenDeltaScalar(in []uint32, prev uint32) int {
+	total := (len(in) + 3) / 4
+	for _, num := range in {
+		total += encode.SizeUint32(num - prev)
+		prev = num
+	}
+	return total
+}
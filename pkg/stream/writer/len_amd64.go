@@ -0,0 +1,56 @@
+// +build amd64
+
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// EncodedLenFast returns the exact number of bytes WriteAll will produce
+// when encoding in. The byte length of every integer is classified 8 at a
+// time using special hardware instructions.
+func EncodedLenFast(in []uint32) int {
+	var (
+		count   = len(in)
+		total   = (count + 3) / 4
+		encoded = 0
+		lowest8 = count &^ 7
+	)
+
+	for ; encoded < lowest8; encoded += 8 {
+		ctrl := encode.Ctrl8uint32FastAsm(in[encoded:])
+		total += shared.ControlByteToSizeTwo(ctrl)
+	}
+
+	for _, num := range in[encoded:] {
+		total += encode.SizeUint32(num)
+	}
+
+	return total
+}
+
+// EncodedLenDeltaFast returns the exact number of bytes WriteAllDelta will
+// produce when differentially encoding in. The byte length of every delta
+// is classified 8 at a time using special hardware instructions.
+func EncodedLenDeltaFast(in []uint32, prev uint32) int {
+	var (
+		count   = len(in)
+		total   = (count + 3) / 4
+		encoded = 0
+		lowest8 = count &^ 7
+	)
+
+	for ; encoded < lowest8; encoded += 8 {
+		ctrl := encode.Ctrl8uint32DeltaFastAsm(in[encoded:], prev)
+		total += shared.ControlByteToSizeTwo(ctrl)
+		prev = in[encoded+7]
+	}
+
+	for _, num := range in[encoded:] {
+		total += encode.SizeUint32(num - prev)
+		prev = num
+	}
+
+	return total
+}
@@ -0,0 +1,70 @@
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/stream/internal/parallel"
+)
+
+// WriteAllParallel works similarly to WriteAll except that the integers are
+// split into chunks which are encoded concurrently by up to workers
+// goroutines. A workers value less than 1 selects runtime.GOMAXPROCS. The
+// output is a regular Stream VByte stream identical to the one produced by
+// WriteAll, regardless of the count of workers.
+func WriteAllParallel(in []uint32, workers int) []byte {
+	return writeAllParallel(in, 0, false, workers)
+}
+
+// WriteAllDeltaParallel works similarly to WriteAllDelta except that the
+// integers are encoded concurrently the same way as WriteAllParallel. The
+// prev of every chunk is simply the last integer of the previous one.
+func WriteAllDeltaParallel(in []uint32, prev uint32, workers int) []byte {
+	return writeAllParallel(in, prev, true, workers)
+}
+
+// writeAllParallel encodes every chunk into the data bytes at the offset
+// of its worst case encoding, so that the chunks never overlap. Once they
+// are all encoded, the chunk offset table is computed and the data bytes
+// are moved down to their final position.
+func writeAllParallel(in []uint32, prev uint32, delta bool, workers int) []byte {
+	var (
+		count   = len(in)
+		ctrlLen = (count + 3) / 4
+		stream  = make([]byte, MaxEncodedLen(count))
+		ctrls   = stream[:ctrlLen]
+		data    = stream[ctrlLen:]
+		chunks  = parallel.Chunks(count)
+		sizes   = make([]int, chunks)
+		tier    = encode.GetTier()
+	)
+
+	parallel.ForEach(chunks, workers, func(c int) {
+		start, end := parallel.Bounds(count, c)
+		nums := in[start:end]
+		chunkCtrls := ctrls[start/4 : (end+3)/4]
+		chunkData := data[start*encode.MaxBytesPerNum : end*encode.MaxBytesPerNum]
+		if delta {
+			sizes[c] = writeAllDeltaTier(tier, nums, chunkCtrls, chunkData, chunkPrev(in, start, prev))
+		} else {
+			sizes[c] = writeAllTier(tier, nums, chunkCtrls, chunkData)
+		}
+	})
+
+	// Every chunk moves down by at least as much as the one before it, so
+	// moving them in order never overwrites data that is yet to be moved.
+	offset := 0
+	for c, size := range sizes {
+		src := c * parallel.ChunkLen * encode.MaxBytesPerNum
+		copy(data[offset:], data[src:src+size])
+		offset += size
+	}
+
+	return stream[:ctrlLen+offset]
+}
+
+// chunkPrev returns the prev of the chunk starting at position start.
+func chunkPrev(in []uint32, start int, prev uint32) uint32 {
+	if start == 0 {
+		return prev
+	}
+	return in[start-1]
+}
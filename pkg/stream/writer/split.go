@@ -0,0 +1,25 @@
+package writer
+
+import "github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+
+// WriteAllSplit works similarly to WriteAll except that the control bytes
+// and the data bytes are returned separately rather than glued together,
+// e.g. to store them in different pages or files, or to compress the
+// control bytes on their own. They are read back with
+// reader.ReadAllSplit.
+func WriteAllSplit(in []uint32) (ctrl, data []byte) {
+	ctrl = make([]byte, (len(in)+3)/4)
+	data = make([]byte, len(in)*encode.MaxBytesPerNum)
+	written := writeAllTier(encode.GetTier(), in, ctrl, data)
+	return ctrl, data[:written]
+}
+
+// WriteAllDeltaSplit works similarly to WriteAllDelta except that the
+// control bytes and the data bytes are returned separately the same way as
+// WriteAllSplit. They are read back with reader.ReadAllDeltaSplit.
+func WriteAllDeltaSplit(in []uint32, prev uint32) (ctrl, data []byte) {
+	ctrl = make([]byte, (len(in)+3)/4)
+	data = make([]byte, len(in)*encode.MaxBytesPerNum)
+	written := writeAllDeltaTier(encode.GetTier(), in, ctrl, data, prev)
+	return ctrl, data[:written]
+}
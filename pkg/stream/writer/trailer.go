@@ -0,0 +1,169 @@
+package writer
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// WriteAllTrailer will encode all the integers from in using the trailer
+// format described in the shared package, i.e. with the control bytes
+// following the data bytes, and will return the byte array holding the
+// encoded data. It will select the best implementation depending on the
+// presence of special hardware instructions.
+func WriteAllTrailer(in []uint32) []byte {
+	return writeAllTrailer(in, 0, false)
+}
+
+// WriteAllDeltaTrailer works similarly to WriteAllTrailer except that the
+// integers are differentially coded starting from prev, which is stored in
+// the footer.
+func WriteAllDeltaTrailer(in []uint32, prev uint32) []byte {
+	return writeAllTrailer(in, prev, true)
+}
+
+func writeAllTrailer(in []uint32, prev uint32, delta bool) []byte {
+	var (
+		ctrls   = make([]byte, (len(in)+3)/4)
+		stream  = make([]byte, MaxEncodedLen(len(in))+shared.TrailerFooterLen)
+		data    = stream[:len(in)*encode.MaxBytesPerNum]
+		written int
+	)
+
+	if delta {
+		written = writeAllDeltaTier(encode.GetTier(), in, ctrls, data, prev)
+	} else {
+		written = writeAllTier(encode.GetTier(), in, ctrls, data)
+	}
+
+	stream = append(stream[:written], ctrls...)
+	return appendTrailerFooter(stream, len(in), prev, delta)
+}
+
+// appendTrailerFooter appends the footer of a stream in the trailer format
+// to dst. prev is only stored for differentially coded streams.
+func appendTrailerFooter(dst []byte, count int, prev uint32, delta bool) []byte {
+	var footer [shared.TrailerFooterLen]byte
+	binary.LittleEndian.PutUint64(footer[0:], uint64(count))
+	if delta {
+		binary.LittleEndian.PutUint32(footer[8:], prev)
+		footer[12] |= shared.TrailerFlagDelta
+	}
+	copy(footer[13:], shared.TrailerMagic)
+	return append(dst, footer[:]...)
+}
+
+// TrailerWriter writes integers to an io.Writer using the trailer format
+// described in the shared package, e.g. for log-style appenders. Integers
+// are buffered into fixed size blocks, the data bytes of which are written
+// out as soon as the block is encoded. The control bytes, a single byte
+// per 4 integers, are kept in memory until Close writes them out along
+// with the footer. A TrailerWriter is not safe for concurrent use.
+type TrailerWriter struct {
+	w       io.Writer
+	opts    EncoderOptions
+	prev    uint32
+	count   int
+	pending []uint32
+	ctrls   []byte
+	data    []byte
+	closed  bool
+	err     error
+}
+
+// NewTrailerWriter returns a TrailerWriter writing to w. opts.BlockLen is
+// rounded up to a multiple of 4 so that every block fills up whole control
+// bytes. Nothing is written to w until the first block is full or Flush or
+// Close are called.
+func NewTrailerWriter(w io.Writer, opts EncoderOptions) *TrailerWriter {
+	if opts.BlockLen < 1 {
+		opts.BlockLen = shared.DefaultFrameBlockLen
+	}
+	if opts.BlockLen > shared.MaxFrameBlockLen {
+		opts.BlockLen = shared.MaxFrameBlockLen
+	}
+	opts.BlockLen = (opts.BlockLen + 3) &^ 3
+
+	return &TrailerWriter{
+		w:       w,
+		opts:    opts,
+		prev:    opts.Prev,
+		pending: make([]uint32, 0, opts.BlockLen),
+		data:    make([]byte, opts.BlockLen*encode.MaxBytesPerNum),
+	}
+}
+
+// Write buffers vals, writing out the data bytes of every block that fills
+// up. When differential coding is enabled, the prev value is carried across
+// calls.
+func (t *TrailerWriter) Write(vals []uint32) error {
+	if t.closed {
+		return ErrClosed
+	}
+
+	for len(vals) > 0 && t.err == nil {
+		n := copy(t.pending[len(t.pending):cap(t.pending)], vals)
+		t.pending = t.pending[:len(t.pending)+n]
+		vals = vals[n:]
+		if len(t.pending) == cap(t.pending) {
+			t.writeBlock(len(t.pending))
+		}
+	}
+
+	return t.err
+}
+
+// Flush writes out the data bytes of the buffered integers, except for the
+// last len%4 ones which stay buffered until their control byte fills up.
+func (t *TrailerWriter) Flush() error {
+	if t.closed {
+		return ErrClosed
+	}
+
+	if n := len(t.pending) &^ 3; t.err == nil && n > 0 {
+		t.writeBlock(n)
+	}
+	return t.err
+}
+
+// Close writes out the data bytes of any buffered integers followed by the
+// control bytes and the footer. It does not close the underlying io.Writer.
+func (t *TrailerWriter) Close() error {
+	if t.closed {
+		return t.err
+	}
+	t.closed = true
+
+	if t.err == nil && len(t.pending) > 0 {
+		t.writeBlock(len(t.pending))
+	}
+	if t.err == nil {
+		_, t.err = t.w.Write(appendTrailerFooter(t.ctrls, t.count, t.opts.Prev, t.opts.Delta))
+	}
+	return t.err
+}
+
+// writeBlock encodes the first n pending integers, keeping their control
+// bytes and writing out their data bytes. n must be a multiple of 4 unless
+// the block is the last one.
+func (t *TrailerWriter) writeBlock(n int) {
+	var (
+		block   = t.pending[:n]
+		start   = len(t.ctrls)
+		written int
+	)
+
+	t.ctrls = grow(t.ctrls, (n+3)/4)
+	if t.opts.Delta {
+		written = writeAllDeltaTier(encode.GetTier(), block, t.ctrls[start:], t.data, t.prev)
+		t.prev = block[n-1]
+	} else {
+		written = writeAllTier(encode.GetTier(), block, t.ctrls[start:], t.data)
+	}
+
+	t.count += n
+	t.pending = t.pending[:copy(t.pending, t.pending[n:])]
+	_, t.err = t.w.Write(t.data[:written])
+}
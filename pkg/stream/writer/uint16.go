@@ -0,0 +1,80 @@
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// MaxEncodedLen16 returns the maximum number of bytes needed to encode
+// count integers using the 16-bit variant of Stream VByte.
+func MaxEncodedLen16(count int) int {
+	return (count+7)/8 + encode.MaxBytesPerNum16*count
+}
+
+// WriteAll16 will encode all the integers from in using the 16-bit variant
+// of Stream VByte, where a single control byte describes 8 integers.
+// Returns the byte array holding the encoded data. It will select the best
+// implementation depending on the presence of special hardware
+// instructions.
+func WriteAll16(in []uint16) []byte {
+	var (
+		count   = len(in)
+		ctrlLen = (count + 7) / 8
+		stream  = make([]byte, MaxEncodedLen16(count))
+
+		dataPos = ctrlLen
+		ctrlPos = 0
+		encoded = 0
+	)
+
+	// The stream is sized for the worst case, so every batch of 8 can
+	// safely be written by the kernels.
+	for ; count-encoded >= 8; ctrlPos += 1 {
+		ctrl := encode.Put8uint16(in[encoded:], stream[dataPos:])
+		stream[ctrlPos] = ctrl
+		encoded += 8
+		dataPos += shared.ControlByteToSize16(ctrl)
+	}
+
+	if ctrlPos < ctrlLen {
+		nums := count - encoded
+		ctrl := encode.PutUint16Scalar(in[encoded:], stream[dataPos:], nums)
+		stream[ctrlPos] = ctrl
+		dataPos += shared.ControlByteToSize16(ctrl) - (8 - nums)
+	}
+
+	return stream[:dataPos]
+}
+
+// WriteAllDelta16 will differentially encode all the integers from in
+// using the 16-bit variant of Stream VByte. Returns the byte array holding
+// the encoded data. It will select the best implementation depending on
+// the presence of special hardware instructions.
+func WriteAllDelta16(in []uint16, prev uint16) []byte {
+	var (
+		count   = len(in)
+		ctrlLen = (count + 7) / 8
+		stream  = make([]byte, MaxEncodedLen16(count))
+
+		dataPos = ctrlLen
+		ctrlPos = 0
+		encoded = 0
+	)
+
+	for ; count-encoded >= 8; ctrlPos += 1 {
+		ctrl := encode.Put8uint16Delta(in[encoded:], stream[dataPos:], prev)
+		stream[ctrlPos] = ctrl
+		prev = in[encoded+7]
+		encoded += 8
+		dataPos += shared.ControlByteToSize16(ctrl)
+	}
+
+	if ctrlPos < ctrlLen {
+		nums := count - encoded
+		ctrl := encode.PutUint16DeltaScalar(in[encoded:], stream[dataPos:], nums, prev)
+		stream[ctrlPos] = ctrl
+		dataPos += shared.ControlByteToSize16(ctrl) - (8 - nums)
+	}
+
+	return stream[:dataPos]
+}
@@ -0,0 +1,94 @@
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// MaxEncodedLen64 returns the maximum number of bytes needed to encode
+// count integers using the 64-bit variant of Stream VByte.
+func MaxEncodedLen64(count int) int {
+	return (count+3)/4 + encode.MaxBytesPerNum64*count
+}
+
+// WriteAll64 will encode all the integers from in using the 64-bit variant
+// of Stream VByte. Returns the byte array holding the encoded data. It will
+// select the best implementation depending on the presence of special
+// hardware instructions.
+func WriteAll64(in []uint64) []byte {
+	var (
+		count   = len(in)
+		ctrlLen = (count + 3) / 4
+		stream  = make([]byte, MaxEncodedLen64(count))
+
+		dataPos = ctrlLen
+		ctrlPos = 0
+		encoded = 0
+	)
+
+	// The stream is sized for the worst case, so every batch of 8 can
+	// safely be written by the kernels.
+	for ; count-encoded >= 8; ctrlPos += 2 {
+		ctrl := encode.Put8uint64(in[encoded:], stream[dataPos:])
+		stream[ctrlPos] = uint8(ctrl & 0xff)
+		stream[ctrlPos+1] = uint8(ctrl >> 8)
+		encoded += 8
+		dataPos += shared.ControlByteToSizeTwo64(ctrl)
+	}
+
+	for ; ctrlPos < ctrlLen; ctrlPos += 1 {
+		nums := count - encoded
+		if nums > 4 {
+			nums = 4
+		}
+		ctrl := encode.PutUint64Scalar(in[encoded:], stream[dataPos:], nums)
+		size := shared.ControlByteToSize64(ctrl)
+		stream[ctrlPos] = ctrl
+		size -= 4 - nums
+		dataPos += size
+		encoded += nums
+	}
+
+	return stream[:dataPos]
+}
+
+// WriteAllDelta64 will differentially encode all the integers from in using
+// the 64-bit variant of Stream VByte. Returns the byte array holding the
+// encoded data. It will select the best implementation depending on the
+// presence of special hardware instructions.
+func WriteAllDelta64(in []uint64, prev uint64) []byte {
+	var (
+		count   = len(in)
+		ctrlLen = (count + 3) / 4
+		stream  = make([]byte, MaxEncodedLen64(count))
+
+		dataPos = ctrlLen
+		ctrlPos = 0
+		encoded = 0
+	)
+
+	for ; count-encoded >= 8; ctrlPos += 2 {
+		ctrl := encode.Put8uint64Delta(in[encoded:], stream[dataPos:], prev)
+		stream[ctrlPos] = uint8(ctrl & 0xff)
+		stream[ctrlPos+1] = uint8(ctrl >> 8)
+		prev = in[encoded+7]
+		encoded += 8
+		dataPos += shared.ControlByteToSizeTwo64(ctrl)
+	}
+
+	for ; ctrlPos < ctrlLen; ctrlPos += 1 {
+		nums := count - encoded
+		if nums > 4 {
+			nums = 4
+		}
+		ctrl := encode.PutUint64DeltaScalar(in[encoded:], stream[dataPos:], nums, prev)
+		size := shared.ControlByteToSize64(ctrl)
+		stream[ctrlPos] = ctrl
+		size -= 4 - nums
+		dataPos += size
+		prev = in[encoded+nums-1]
+		encoded += nums
+	}
+
+	return stream[:dataPos]
+}
@@ -0,0 +1,94 @@
+package writer
+
+import (
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/encode"
+	"github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+)
+
+// WriteAllVariant will encode all the integers from in using the provided
+// variant of Stream VByte. Returns the byte array holding the encoded data.
+// The stream of the "0124" variant is byte compatible with the
+// streamvbyte_encode_0124 func of the reference C implementation. It will
+// select the best implementation depending on the presence of special
+// hardware instructions.
+func WriteAllVariant(in []uint32, v shared.Variant) []byte {
+	if v != shared.Variant0124 {
+		return WriteAll(in)
+	}
+
+	var (
+		count   = len(in)
+		ctrlLen = (count + 3) / 4
+		stream  = make([]byte, MaxEncodedLen(count))
+
+		dataPos = ctrlLen
+		ctrlPos = 0
+		encoded = 0
+	)
+
+	// The stream is sized for the worst case, so every batch of 8 can
+	// safely be written by the kernels.
+	for ; count-encoded >= 8; ctrlPos += 2 {
+		ctrl := encode.Put8uint32Variant(in[encoded:], stream[dataPos:], v)
+		stream[ctrlPos] = uint8(ctrl & 0xff)
+		stream[ctrlPos+1] = uint8(ctrl >> 8)
+		encoded += 8
+		dataPos += shared.ControlByteToSizeTwo0124(ctrl)
+	}
+
+	for ; ctrlPos < ctrlLen; ctrlPos += 1 {
+		nums := count - encoded
+		if nums > 4 {
+			nums = 4
+		}
+		ctrl := encode.PutUint32Scalar0124(in[encoded:], stream[dataPos:], nums)
+		stream[ctrlPos] = ctrl
+		dataPos += shared.ControlByteToSize0124(ctrl)
+		encoded += nums
+	}
+
+	return stream[:dataPos]
+}
+
+// WriteAllDeltaVariant will differentially encode all the integers from in
+// using the provided variant of Stream VByte. Returns the byte array holding
+// the encoded data. It will select the best implementation depending on the
+// presence of special hardware instructions.
+func WriteAllDeltaVariant(in []uint32, prev uint32, v shared.Variant) []byte {
+	if v != shared.Variant0124 {
+		return WriteAllDelta(in, prev)
+	}
+
+	var (
+		count   = len(in)
+		ctrlLen = (count + 3) / 4
+		stream  = make([]byte, MaxEncodedLen(count))
+
+		dataPos = ctrlLen
+		ctrlPos = 0
+		encoded = 0
+	)
+
+	for ; count-encoded >= 8; ctrlPos += 2 {
+		ctrl := encode.Put8uint32DeltaVariant(in[encoded:], stream[dataPos:], prev, v)
+		stream[ctrlPos] = uint8(ctrl & 0xff)
+		stream[ctrlPos+1] = uint8(ctrl >> 8)
+		prev = in[encoded+7]
+		encoded += 8
+		dataPos += shared.ControlByteToSizeTwo0124(ctrl)
+	}
+
+	for ; ctrlPos < ctrlLen; ctrlPos += 1 {
+		nums := count - encoded
+		if nums > 4 {
+			nums = 4
+		}
+		ctrl := encode.PutUint32DeltaScalar0124(in[encoded:], stream[dataPos:], nums, prev)
+		stream[ctrlPos] = ctrl
+		dataPos += shared.ControlByteToSize0124(ctrl)
+		prev = in[encoded+nums-1]
+		encoded += nums
+	}
+
+	return stream[:dataPos]
+}
@@ -15,11 +15,7 @@ const (
 // select the best implementation depending on the presence of special
 // hardware instructions.
 func WriteAll(in []uint32) []byte {
-	if encode.GetMode() == shared.Fast {
-		return WriteAllFast(in)
-	} else {
-		return WriteAllScalar(in)
-	}
+	return WriteAllOptions(in, shared.Options{})
 }
 
 // WriteAllDelta will differentially encode all the integers from in using
@@ -27,22 +23,92 @@ func WriteAll(in []uint32) []byte {
 // data. It will select the best implementation depending on the presence of
 // special hardware instructions.
 func WriteAllDelta(in []uint32, prev uint32) []byte {
-	if encode.GetMode() == shared.Fast {
-		return WriteAllDeltaFast(in, prev)
-	} else {
-		return WriteAllDeltaScalar(in, prev)
+	return WriteAllDeltaOptions(in, prev, shared.Options{})
+}
+
+// WriteAllOptions works similarly to WriteAll except that the implementation
+// is selected by opts for this call only, e.g. to compare the scalar and
+// accelerated implementations within the same process. If opts.Padded is
+// set, shared.StreamPadding zero bytes are appended to the stream.
+func WriteAllOptions(in []uint32, opts shared.Options) []byte {
+	ctrlLen := (len(in) + 3) / 4
+	stream := make([]byte, MaxEncodedLen(len(in))+shared.StreamPadding)
+	written := writeAllTier(tierOf(opts), in, stream[:ctrlLen], stream[ctrlLen:])
+	return padStream(stream, ctrlLen+written, opts)
+}
+
+// WriteAllDeltaOptions works similarly to WriteAllDelta except that the
+// implementation is selected by opts for this call only.
+func WriteAllDeltaOptions(in []uint32, prev uint32, opts shared.Options) []byte {
+	ctrlLen := (len(in) + 3) / 4
+	stream := make([]byte, MaxEncodedLen(len(in))+shared.StreamPadding)
+	written := writeAllDeltaTier(tierOf(opts), in, stream[:ctrlLen], stream[ctrlLen:], prev)
+	return padStream(stream, ctrlLen+written, opts)
+}
+
+// padStream returns the first n bytes of stream followed by
+// shared.StreamPadding zero bytes if opts.Padded is set. stream must be
+// able to hold them.
+func padStream(stream []byte, n int, opts shared.Options) []byte {
+	if !opts.Padded {
+		return stream[:n]
+	}
+	padding := stream[n : n+shared.StreamPadding]
+	for i := range padding {
+		padding[i] = 0
+	}
+	return stream[:n+shared.StreamPadding]
+}
+
+// tierOf returns the tier of kernels to use for a call configured with opts.
+func tierOf(opts shared.Options) shared.Tier {
+	return opts.Tier(encode.GetTier(), encode.SupportedTier())
+}
+
+// writeAllTier encodes in writing the control bytes into ctrls and the data
+// bytes into data with the kernel selected at tier t. Returns the number of
+// data bytes written.
+func writeAllTier(t shared.Tier, in []uint32, ctrls, data []byte) int {
+	switch k := putKernels[t]; k.Name {
+	case shared.KernelScalar:
+		return writeAllScalar(in, ctrls, data)
+	case shared.KernelSSE41, shared.KernelAVX2:
+		return writeAllFast(k.Tier, in, ctrls, data)
+	default:
+		return writeAllKernel(k.Func.(func([]uint32, []byte) uint16), in, ctrls, data)
+	}
+}
+
+// writeAllDeltaTier differentially encodes in writing the control bytes
+// into ctrls and the data bytes into data with the kernel selected at tier
+// t. Returns the number of data bytes written.
+func writeAllDeltaTier(t shared.Tier, in []uint32, ctrls, data []byte, prev uint32) int {
+	switch k := putDeltaKernels[t]; k.Name {
+	case shared.KernelScalar:
+		return writeAllDeltaScalar(in, ctrls, data, prev)
+	case shared.KernelSSE41, shared.KernelAVX2:
+		return writeAllDeltaFast(k.Tier, in, ctrls, data, prev)
+	default:
+		return writeAllDeltaKernel(k.Func.(func([]uint32, []byte, uint32) uint16), in, ctrls, data, prev)
 	}
 }
 
 // WriteAllScalar will encode all the integers from in using the Stream VByte
 // format and will return the byte array holding the encoded data.
 func WriteAllScalar(in []uint32) []byte {
-	var (
-		count   = len(in)
-		ctrlLen = (count + 3) / 4
-		stream  = make([]byte, ctrlLen+(encode.MaxBytesPerNum*count))
+	ctrlLen := (len(in) + 3) / 4
+	stream := make([]byte, MaxEncodedLen(len(in)))
+	written := writeAllScalar(in, stream[:ctrlLen], stream[ctrlLen:])
+	return stream[:ctrlLen+written]
+}
 
-		dataPos    = ctrlLen
+// writeAllScalar encodes in writing the control bytes into ctrls and
+// the data bytes into data, which must be able to hold the worst case
+// encoding. Returns the number of data bytes written.
+func writeAllScalar(in []uint32, ctrls, data []byte) int {
+	var (
+		count      = len(in)
+		dataPos    = 0
 		ctrlPos    = 0
 		encoded    = 0
 		lowestJump = count &^ (jump - 1)
@@ -51,23 +117,23 @@ func WriteAllScalar(in []uint32) []byte {
 
 	for ; encoded < lowestJump; encoded += jump {
 		nums := in[encoded : encoded+jump]
-		data := stream[dataPos:]
-		ctrls := stream[ctrlPos : ctrlPos+jumpCtrl]
+		out := data[dataPos:]
+		quad := ctrls[ctrlPos : ctrlPos+jumpCtrl]
 
-		ctrl := encode.Put4uint32Scalar(nums, data)
-		ctrls[0] = ctrl
+		ctrl := encode.Put4uint32Scalar(nums, out)
+		quad[0] = ctrl
 		sizeA := shared.ControlByteToSize(ctrl)
 
-		ctrl = encode.Put4uint32Scalar(nums[4:], data[sizeA:])
-		ctrls[1] = ctrl
+		ctrl = encode.Put4uint32Scalar(nums[4:], out[sizeA:])
+		quad[1] = ctrl
 		sizeB := shared.ControlByteToSize(ctrl)
 
-		ctrl = encode.Put4uint32Scalar(nums[8:], data[sizeA+sizeB:])
-		ctrls[2] = ctrl
+		ctrl = encode.Put4uint32Scalar(nums[8:], out[sizeA+sizeB:])
+		quad[2] = ctrl
 		sizeC := shared.ControlByteToSize(ctrl)
 
-		ctrl = encode.Put4uint32Scalar(nums[12:], data[sizeA+sizeB+sizeC:])
-		ctrls[3] = ctrl
+		ctrl = encode.Put4uint32Scalar(nums[12:], out[sizeA+sizeB+sizeC:])
+		quad[3] = ctrl
 		sizeD := shared.ControlByteToSize(ctrl)
 
 		dataPos += sizeA + sizeB + sizeC + sizeD
@@ -75,8 +141,8 @@ func WriteAllScalar(in []uint32) []byte {
 	}
 
 	for ; encoded < lowest4; encoded += 4 {
-		ctrl := encode.Put4uint32Scalar(in[encoded:], stream[dataPos:])
-		stream[ctrlPos] = ctrl
+		ctrl := encode.Put4uint32Scalar(in[encoded:], data[dataPos:])
+		ctrls[ctrlPos] = ctrl
 		size := shared.ControlByteToSize(ctrl)
 		dataPos += size
 		ctrlPos++
@@ -84,25 +150,32 @@ func WriteAllScalar(in []uint32) []byte {
 
 	if lowest4 != count {
 		nums := count - lowest4
-		ctrl := encode.PutUint32Scalar(in[encoded:], stream[dataPos:], nums)
+		ctrl := encode.PutUint32Scalar(in[encoded:], data[dataPos:], nums)
 		size := shared.ControlByteToSize(ctrl)
 		size -= 4 - nums
 		dataPos += size
-		stream[ctrlPos] = ctrl
+		ctrls[ctrlPos] = ctrl
 	}
 
-	return stream[:dataPos]
+	return dataPos
 }
 
 // WriteAllDeltaScalar will differentially encode all the integers from in using
 // the Stream VByte format and will return the byte array holding the encoded data.
 func WriteAllDeltaScalar(in []uint32, prev uint32) []byte {
-	var (
-		count   = len(in)
-		ctrlLen = (count + 3) / 4
-		stream  = make([]byte, ctrlLen+(encode.MaxBytesPerNum*count))
+	ctrlLen := (len(in) + 3) / 4
+	stream := make([]byte, MaxEncodedLen(len(in)))
+	written := writeAllDeltaScalar(in, stream[:ctrlLen], stream[ctrlLen:], prev)
+	return stream[:ctrlLen+written]
+}
 
-		dataPos    = ctrlLen
+// writeAllDeltaScalar differentially encodes in writing the control bytes
+// into ctrls and the data bytes into data, which must be able to hold the
+// worst case encoding. Returns the number of data bytes written.
+func writeAllDeltaScalar(in []uint32, ctrls, data []byte, prev uint32) int {
+	var (
+		count      = len(in)
+		dataPos    = 0
 		ctrlPos    = 0
 		encoded    = 0
 		lowestJump = count &^ (jump - 1)
@@ -111,23 +184,23 @@ func WriteAllDeltaScalar(in []uint32, prev uint32) []byte {
 
 	for ; encoded < lowestJump; encoded += jump {
 		nums := in[encoded : encoded+jump]
-		data := stream[dataPos:]
-		ctrls := stream[ctrlPos : ctrlPos+jumpCtrl]
+		out := data[dataPos:]
+		quad := ctrls[ctrlPos : ctrlPos+jumpCtrl]
 
-		ctrl := encode.Put4uint32DeltaScalar(nums, data, prev)
-		ctrls[0] = ctrl
+		ctrl := encode.Put4uint32DeltaScalar(nums, out, prev)
+		quad[0] = ctrl
 		sizeA := shared.ControlByteToSize(ctrl)
 
-		ctrl = encode.Put4uint32DeltaScalar(nums[4:], data[sizeA:], nums[3])
-		ctrls[1] = ctrl
+		ctrl = encode.Put4uint32DeltaScalar(nums[4:], out[sizeA:], nums[3])
+		quad[1] = ctrl
 		sizeB := shared.ControlByteToSize(ctrl)
 
-		ctrl = encode.Put4uint32DeltaScalar(nums[8:], data[sizeA+sizeB:], nums[7])
-		ctrls[2] = ctrl
+		ctrl = encode.Put4uint32DeltaScalar(nums[8:], out[sizeA+sizeB:], nums[7])
+		quad[2] = ctrl
 		sizeC := shared.ControlByteToSize(ctrl)
 
-		ctrl = encode.Put4uint32DeltaScalar(nums[12:], data[sizeA+sizeB+sizeC:], nums[11])
-		ctrls[3] = ctrl
+		ctrl = encode.Put4uint32DeltaScalar(nums[12:], out[sizeA+sizeB+sizeC:], nums[11])
+		quad[3] = ctrl
 		sizeD := shared.ControlByteToSize(ctrl)
 
 		dataPos += sizeA + sizeB + sizeC + sizeD
@@ -136,8 +209,8 @@ func WriteAllDeltaScalar(in []uint32, prev uint32) []byte {
 	}
 
 	for ; encoded < lowest4; encoded += 4 {
-		ctrl := encode.Put4uint32DeltaScalar(in[encoded:], stream[dataPos:], prev)
-		stream[ctrlPos] = ctrl
+		ctrl := encode.Put4uint32DeltaScalar(in[encoded:], data[dataPos:], prev)
+		ctrls[ctrlPos] = ctrl
 		size := shared.ControlByteToSize(ctrl)
 		dataPos += size
 		ctrlPos++
@@ -146,12 +219,12 @@ func WriteAllDeltaScalar(in []uint32, prev uint32) []byte {
 
 	if lowest4 != count {
 		nums := count - lowest4
-		ctrl := encode.PutUint32DeltaScalar(in[encoded:], stream[dataPos:], nums, prev)
+		ctrl := encode.PutUint32DeltaScalar(in[encoded:], data[dataPos:], nums, prev)
 		size := shared.ControlByteToSize(ctrl)
 		size -= 4 - nums
 		dataPos += size
-		stream[ctrlPos] = ctrl
+		ctrls[ctrlPos] = ctrl
 	}
 
-	return stream[:dataPos]
+	return dataPos
 }
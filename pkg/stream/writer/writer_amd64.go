@@ -9,66 +9,90 @@ import (
 
 // WriteAllFast will encode all the integers from in using the Stream VByte
 // format using special hardware instructions and will return the byte array
-// holding the encoded data.
+// holding the encoded data. It uses the highest tier the CPU supports
+// regardless of the shared.Mode, or the scalar implementation if there is
+// none or after a shared.Fallback.
 func WriteAllFast(in []uint32) []byte {
+	ctrlLen := (len(in) + 3) / 4
+	stream := make([]byte, MaxEncodedLen(len(in)))
+	written := writeAllFast(encode.SupportedTier(), in, stream[:ctrlLen], stream[ctrlLen:])
+	return stream[:ctrlLen+written]
+}
+
+// writeAllFast encodes in writing the control bytes into ctrls and
+// the data bytes into data, which must be able to hold the worst case
+// encoding, with the SSE kernels if t is shared.TierSSE41, the scalar
+// implementation if t is shared.TierScalar and the AVX ones otherwise. The
+// last integers are encoded 4 at a time with encode.PutNuint32Fast, or with
+// the scalar implementation since the SSE kernels lack masked moves.
+// Returns the number of data bytes written.
+func writeAllFast(t shared.Tier, in []uint32, ctrls, data []byte) int {
+	if t == shared.TierScalar {
+		return writeAllScalar(in, ctrls, data)
+	}
+
+	put8, putN := encode.Put8uint32FastAsm, encode.PutNuint32Fast
+	if t == shared.TierSSE41 {
+		put8, putN = encode.Put8uint32SSEAsm, encode.PutUint32Scalar
+	}
+
 	var (
 		count   = len(in)
-		ctrlLen = (count + 3) / 4
-		stream  = make([]byte, ctrlLen+(encode.MaxBytesPerNum*count))
+		ctrlLen = len(ctrls)
 
-		dataPos  = ctrlLen
+		dataPos  = 0
 		ctrlPos  = 0
 		encoded  = 0
 		lowest32 = ((ctrlLen - 3) * 4) &^ 31
 	)
 
 	for ; encoded < lowest32; encoded += 32 {
-		ctrls := stream[ctrlPos : ctrlPos+8]
+		octet := ctrls[ctrlPos : ctrlPos+8]
 		nums := in[encoded : encoded+32]
-		out := stream[dataPos:]
+		out := data[dataPos:]
 
-		ctrl := encode.Put8uint32FastAsm(
+		ctrl := put8(
 			nums[0:8],
 			out,
 			shared.EncodeShuffleTable,
 			shared.PerControlLenTable,
 		)
 
-		ctrls[0] = uint8(ctrl & 0xff)
-		ctrls[1] = uint8(ctrl >> 8)
+		octet[0] = uint8(ctrl & 0xff)
+		octet[1] = uint8(ctrl >> 8)
 		sizeA := shared.ControlByteToSizeTwo(ctrl)
 
-		ctrl = encode.Put8uint32FastAsm(
+		ctrl = put8(
 			nums[8:16],
 			out[sizeA:],
 			shared.EncodeShuffleTable,
 			shared.PerControlLenTable,
 		)
 
-		ctrls[2] = uint8(ctrl & 0xff)
-		ctrls[3] = uint8(ctrl >> 8)
+		octet[2] = uint8(ctrl & 0xff)
+		octet[3] = uint8(ctrl >> 8)
 		sizeB := shared.ControlByteToSizeTwo(ctrl)
 
-		ctrl = encode.Put8uint32FastAsm(
+		ctrl = put8(
 			nums[16:24],
 			out[sizeA+sizeB:],
 			shared.EncodeShuffleTable,
 			shared.PerControlLenTable,
 		)
 
-		ctrls[4] = uint8(ctrl & 0xff)
-		ctrls[5] = uint8(ctrl >> 8)
+		octet[4] = uint8(ctrl & 0xff)
+		octet[5] = uint8(ctrl >> 8)
 		sizeC := shared.ControlByteToSizeTwo(ctrl)
 
-		ctrl = encode.Put8uint32FastAsm(
+		ctrl = put8(
 			nums[24:],
 			out[sizeA+sizeB+sizeC:],
 			shared.EncodeShuffleTable,
 			shared.PerControlLenTable,
 		)
 
-		ctrls[6] = uint8(ctrl & 0xff)
-		ctrls[7] = uint8(ctrl >> 8)
+		octet[6] = uint8(ctrl & 0xff)
+		octet[7] = uint8(ctrl >> 8)
 		sizeD := shared.ControlByteToSizeTwo(ctrl)
 
 		ctrlPos += 8
@@ -76,15 +100,15 @@ func WriteAllFast(in []uint32) []byte {
 	}
 
 	for ; ctrlPos < ctrlLen-2; ctrlPos += 2 {
-		ctrl := encode.Put8uint32FastAsm(
+		ctrl := put8(
 			in[encoded:],
-			stream[dataPos:],
+			data[dataPos:],
 			shared.EncodeShuffleTable,
 			shared.PerControlLenTable,
 		)
 
-		stream[ctrlPos] = uint8(ctrl & 0xff)
-		stream[ctrlPos+1] = uint8(ctrl >> 8)
+		ctrls[ctrlPos] = uint8(ctrl & 0xff)
+		ctrls[ctrlPos+1] = uint8(ctrl >> 8)
 		encoded += 8
 		dataPos += shared.ControlByteToSizeTwo(ctrl)
 	}
@@ -94,38 +118,59 @@ func WriteAllFast(in []uint32) []byte {
 		if nums > 4 {
 			nums = 4
 		}
-		ctrl := encode.PutUint32Scalar(in[encoded:], stream[dataPos:], nums)
+		ctrl := putN(in[encoded:], data[dataPos:], nums)
 		size := shared.ControlByteToSize(ctrl)
-		stream[ctrlPos] = ctrl
+		ctrls[ctrlPos] = ctrl
 		size -= 4 - nums
 		dataPos += size
 		encoded += nums
 	}
 
-	return stream[:dataPos]
+	return dataPos
 }
 
 // WriteAllDeltaFast will differentially encode all the integers from in using
 // the Stream VByte format using special hardware instructions and will return
-// the byte array holding the encoded data.
+// the byte array holding the encoded data. Like WriteAllFast, it uses the
+// highest supported tier or the scalar implementation.
 func WriteAllDeltaFast(in []uint32, prev uint32) []byte {
+	ctrlLen := (len(in) + 3) / 4
+	stream := make([]byte, MaxEncodedLen(len(in)))
+	written := writeAllDeltaFast(encode.SupportedTier(), in, stream[:ctrlLen], stream[ctrlLen:], prev)
+	return stream[:ctrlLen+written]
+}
+
+// writeAllDeltaFast differentially encodes in writing the control bytes
+// into ctrls and the data bytes into data, which must be able to hold the
+// worst case encoding, with the SSE kernels if t is shared.TierSSE41, the
+// scalar implementation if t is shared.TierScalar and the AVX ones
+// otherwise. Returns the number of data bytes written.
+func writeAllDeltaFast(t shared.Tier, in []uint32, ctrls, data []byte, prev uint32) int {
+	if t == shared.TierScalar {
+		return writeAllDeltaScalar(in, ctrls, data, prev)
+	}
+
+	put8Delta, putNDelta := encode.Put8uint32DeltaFastAsm, encode.PutNuint32DeltaFast
+	if t == shared.TierSSE41 {
+		put8Delta, putNDelta = encode.Put8uint32DeltaSSEAsm, encode.PutUint32DeltaScalar
+	}
+
 	var (
 		count   = len(in)
-		ctrlLen = (count + 3) / 4
-		stream  = make([]byte, ctrlLen+(encode.MaxBytesPerNum*count))
+		ctrlLen = len(ctrls)
 
-		dataPos  = ctrlLen
+		dataPos  = 0
 		ctrlPos  = 0
 		encoded  = 0
 		lowest32 = ((ctrlLen - 3) * 4) &^ 31
 	)
 
 	for ; encoded < lowest32; encoded += 32 {
-		ctrls := stream[ctrlPos : ctrlPos+8]
+		octet := ctrls[ctrlPos : ctrlPos+8]
 		nums := in[encoded : encoded+32]
-		out := stream[dataPos:]
+		out := data[dataPos:]
 
-		ctrl := encode.Put8uint32DeltaFastAsm(
+		ctrl := put8Delta(
 			nums[0:8],
 			out,
 			prev,
@@ -133,11 +178,11 @@ func WriteAllDeltaFast(in []uint32, prev uint32) []byte {
 			shared.PerControlLenTable,
 		)
 
-		ctrls[0] = uint8(ctrl & 0xff)
-		ctrls[1] = uint8(ctrl >> 8)
+		octet[0] = uint8(ctrl & 0xff)
+		octet[1] = uint8(ctrl >> 8)
 		sizeA := shared.ControlByteToSizeTwo(ctrl)
 
-		ctrl = encode.Put8uint32DeltaFastAsm(
+		ctrl = put8Delta(
 			nums[8:16],
 			out[sizeA:],
 			nums[7],
@@ -145,11 +190,11 @@ func WriteAllDeltaFast(in []uint32, prev uint32) []byte {
 			shared.PerControlLenTable,
 		)
 
-		ctrls[2] = uint8(ctrl & 0xff)
-		ctrls[3] = uint8(ctrl >> 8)
+		octet[2] = uint8(ctrl & 0xff)
+		octet[3] = uint8(ctrl >> 8)
 		sizeB := shared.ControlByteToSizeTwo(ctrl)
 
-		ctrl = encode.Put8uint32DeltaFastAsm(
+		ctrl = put8Delta(
 			nums[16:24],
 			out[sizeA+sizeB:],
 			nums[15],
@@ -157,11 +202,11 @@ func WriteAllDeltaFast(in []uint32, prev uint32) []byte {
 			shared.PerControlLenTable,
 		)
 
-		ctrls[4] = uint8(ctrl & 0xff)
-		ctrls[5] = uint8(ctrl >> 8)
+		octet[4] = uint8(ctrl & 0xff)
+		octet[5] = uint8(ctrl >> 8)
 		sizeC := shared.ControlByteToSizeTwo(ctrl)
 
-		ctrl = encode.Put8uint32DeltaFastAsm(
+		ctrl = put8Delta(
 			nums[24:],
 			out[sizeA+sizeB+sizeC:],
 			nums[23],
@@ -169,8 +214,8 @@ func WriteAllDeltaFast(in []uint32, prev uint32) []byte {
 			shared.PerControlLenTable,
 		)
 
-		ctrls[6] = uint8(ctrl & 0xff)
-		ctrls[7] = uint8(ctrl >> 8)
+		octet[6] = uint8(ctrl & 0xff)
+		octet[7] = uint8(ctrl >> 8)
 		sizeD := shared.ControlByteToSizeTwo(ctrl)
 
 		ctrlPos += 8
@@ -179,16 +224,16 @@ func WriteAllDeltaFast(in []uint32, prev uint32) []byte {
 	}
 
 	for ; ctrlPos < ctrlLen-2; ctrlPos += 2 {
-		ctrl := encode.Put8uint32DeltaFastAsm(
+		ctrl := put8Delta(
 			in[encoded:],
-			stream[dataPos:],
+			data[dataPos:],
 			prev,
 			shared.EncodeShuffleTable,
 			shared.PerControlLenTable,
 		)
 
-		stream[ctrlPos] = uint8(ctrl & 0xff)
-		stream[ctrlPos+1] = uint8(ctrl >> 8)
+		ctrls[ctrlPos] = uint8(ctrl & 0xff)
+		ctrls[ctrlPos+1] = uint8(ctrl >> 8)
 		encoded += 8
 		dataPos += shared.ControlByteToSizeTwo(ctrl)
 		prev = in[encoded-1]
@@ -199,14 +244,14 @@ func WriteAllDeltaFast(in []uint32, prev uint32) []byte {
 		if nums > 4 {
 			nums = 4
 		}
-		ctrl := encode.PutUint32DeltaScalar(in[encoded:], stream[dataPos:], nums, prev)
+		ctrl := putNDelta(in[encoded:], data[dataPos:], nums, prev)
 		size := shared.ControlByteToSize(ctrl)
-		stream[ctrlPos] = ctrl
+		ctrls[ctrlPos] = ctrl
 		size -= 4 - nums
 		dataPos += size
 		encoded += nums
 		prev = in[encoded-1]
 	}
 
-	return stream[:dataPos]
+	return dataPos
 }
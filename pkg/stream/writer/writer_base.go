@@ -2,6 +2,28 @@
 
 package writer
 
+import "github.com/theMPatel/streamvbyte-simdgo/pkg/shared"
+
 func WriteAllFast(in []uint32) []byte {
 	panic("unreachable")
 }
+
+func WriteAllDeltaFast(in []uint32, prev uint32) []byte {
+	panic("unreachable")
+}
+
+func EncodedLenFast(in []uint32) int {
+	panic("unreachable")
+}
+
+func EncodedLenDeltaFast(in []uint32, prev uint32) int {
+	panic("unreachable")
+}
+
+func writeAllFast(t shared.Tier, in []uint32, ctrls, data []byte) int {
+	panic("unreachable")
+}
+
+func writeAllDeltaFast(t shared.Tier, in []uint32, ctrls, data []byte, prev uint32) int {
+	panic("unreachable")
+}
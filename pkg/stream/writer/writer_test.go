@@ -1,6 +1,7 @@
 package writer
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -53,10 +54,6 @@ func TestWriteAllDeltaScalar(t *testing.T) {
 }
 
 func TestWriteAllFast(t *testing.T) {
-	if encode.GetMode() == shared.Normal {
-		t.Skipf("Testing environment doesn't support this test")
-	}
-
 	for i := 0; i < 6; i++ {
 		count := int(util.RandUint32() % 1e6)
 		nums := util.GenUint32(count)
@@ -71,10 +68,6 @@ func TestWriteAllFast(t *testing.T) {
 }
 
 func TestWriteAllDeltaFast(t *testing.T) {
-	if encode.GetMode() == shared.Normal {
-		t.Skipf("Testing environment doesn't support this test")
-	}
-
 	for i := 0; i < 6; i++ {
 		count := int(util.RandUint32() % 1e6)
 		nums := util.GenUint32(count)
@@ -92,6 +85,411 @@ func TestWriteAllDeltaFast(t *testing.T) {
 	}
 }
 
+func TestWriteAllOptions(t *testing.T) {
+	defer shared.SetMode(shared.GetMode())
+
+	for _, mode := range []shared.PerformanceMode{shared.Normal, shared.Fast} {
+		shared.SetMode(mode)
+		if mode == shared.Normal && encode.GetTier() != shared.TierScalar {
+			t.Fatalf("expected tier %v, got %v", shared.TierScalar, encode.GetTier())
+		}
+
+		for _, impl := range []shared.Impl{shared.ImplDefault, shared.ImplScalar, shared.ImplFast} {
+			count := int(util.RandUint32() % 1e5)
+			nums := util.GenUint32(count)
+			prev := util.RandUint32()
+			opts := shared.Options{Impl: impl}
+			t.Run(fmt.Sprintf("WriteAllOptions %v, %d: %d", mode, impl, count), func(t *testing.T) {
+				if !reflect.DeepEqual(WriteAllScalar(nums), WriteAllOptions(nums, opts)) {
+					t.Fatalf("encoded wrong stream")
+				}
+				if !reflect.DeepEqual(WriteAllDeltaScalar(nums, prev), WriteAllDeltaOptions(nums, prev, opts)) {
+					t.Fatalf("encoded wrong delta stream")
+				}
+			})
+		}
+	}
+}
+
+func TestRegisterKernel(t *testing.T) {
+	var calls int
+	put8 := func(in []uint32, out []byte) uint16 {
+		calls++
+		return encode.Put8uint32Scalar(in, out)
+	}
+	put8Delta := func(in []uint32, out []byte, prev uint32) uint16 {
+		calls++
+		return encode.Put8uint32DeltaScalar(in, out, prev)
+	}
+
+	tier := encode.GetTier()
+	for _, k := range []shared.Kernel{
+		{Op: encode.OpPut8uint32, Name: "test", Tier: tier, Func: put8},
+		{Op: encode.OpPut8uint32Delta, Name: "test", Tier: tier, Func: put8Delta},
+	} {
+		if err := shared.Register(k); err != nil {
+			t.Fatalf("unexpected error registering %s: %v", k.Op, err)
+		}
+		defer shared.Unregister(k.Op, k.Name)
+	}
+
+	for _, impl := range shared.Implementations() {
+		if impl.Op == encode.OpPut8uint32 && impl.Selected != (impl.Name == "test") {
+			t.Fatalf("unexpected selection of %s: %s", impl.Name, impl.Reason)
+		}
+	}
+
+	count := int(util.RandUint32()%1e4) + 8
+	nums := util.GenUint32(count)
+	prev := util.RandUint32()
+	if !reflect.DeepEqual(WriteAllScalar(nums), WriteAll(nums)) {
+		t.Fatalf("encoded wrong stream")
+	}
+	if !reflect.DeepEqual(WriteAllDeltaScalar(nums, prev), WriteAllDelta(nums, prev)) {
+		t.Fatalf("encoded wrong delta stream")
+	}
+	if calls != 2*(count/8) {
+		t.Fatalf("expected %d calls of the registered kernels, got %d", 2*(count/8), calls)
+	}
+
+	out := make([]byte, 8*encode.MaxBytesPerNum)
+	if encode.Put8uint32(nums, out); calls != 2*(count/8)+1 {
+		t.Fatalf("registered kernel not used by encode.Put8uint32")
+	}
+
+	for _, k := range []shared.Kernel{
+		{Op: "encode.Unknown", Name: "bad", Func: put8},
+		{Op: encode.OpPut8uint32, Name: shared.KernelAVX2, Func: put8},
+		{Op: encode.OpPut8uint32, Name: "test", Func: put8},
+		{Op: encode.OpPut8uint32, Name: "bad", Func: put8Delta},
+		{Op: encode.OpPut8uint32, Name: "bad"},
+	} {
+		if err := shared.Register(k); err == nil {
+			t.Fatalf("expected error registering %+v", k)
+		}
+	}
+}
+
+func TestWriteAllInt32(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenInt32(count)
+		zigzagged := make([]uint32, count)
+		for i, num := range nums {
+			zigzagged[i] = uint32(num<<1) ^ uint32(num>>31)
+		}
+
+		stream := WriteAllScalar(zigzagged)
+		t.Run(fmt.Sprintf("WriteAll: %d", count), func(t *testing.T) {
+			actual := WriteAllInt32(nums)
+			if !reflect.DeepEqual(stream, actual) {
+				t.Fatalf("bad encoding")
+			}
+		})
+	}
+}
+
+func TestWriteAll64(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint64(count)
+		stream := WriteAll64(nums)
+		t.Run(fmt.Sprintf("WriteAll: %d", count), func(t *testing.T) {
+			if len(stream) > MaxEncodedLen64(count) {
+				t.Fatalf("stream exceeds max encoded len")
+			}
+
+			out := make([]uint64, count)
+			reader.ReadAll64(count, stream, out)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
+func TestWriteAllDelta64(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint64(count)
+		util.SortUint64(nums)
+		diffed := make([]uint64, count)
+		for i := range nums {
+			if i > 0 {
+				diffed[i] = nums[i] - nums[i-1]
+			} else {
+				diffed[i] = nums[i]
+			}
+		}
+
+		stream := WriteAll64(diffed)
+		t.Run(fmt.Sprintf("WriteAll: %d", count), func(t *testing.T) {
+			actual := WriteAllDelta64(nums, 0)
+			if !reflect.DeepEqual(stream, actual) {
+				t.Fatalf("bad encoding")
+			}
+		})
+	}
+}
+
+func TestWriteAll16(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint16(count)
+		stream := WriteAll16(nums)
+		t.Run(fmt.Sprintf("WriteAll: %d", count), func(t *testing.T) {
+			if len(stream) > MaxEncodedLen16(count) {
+				t.Fatalf("stream exceeds max encoded len")
+			}
+
+			out := make([]uint16, count)
+			reader.ReadAll16(count, stream, out)
+			if !reflect.DeepEqual(nums, out) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
+func TestWriteAllDelta16(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint16(count)
+		util.SortUint16(nums)
+		diffed := make([]uint16, count)
+		for i := range nums {
+			if i > 0 {
+				diffed[i] = nums[i] - nums[i-1]
+			} else {
+				diffed[i] = nums[i]
+			}
+		}
+
+		stream := WriteAll16(diffed)
+		t.Run(fmt.Sprintf("WriteAll: %d", count), func(t *testing.T) {
+			actual := WriteAllDelta16(nums, 0)
+			if !reflect.DeepEqual(stream, actual) {
+				t.Fatalf("bad encoding")
+			}
+		})
+	}
+}
+
+func TestWriteAllVariant(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint32(count)
+		for i := range nums {
+			if rand.Intn(2) == 0 {
+				nums[i] = 0
+			}
+		}
+
+		stream := WriteAllVariant(nums, shared.Variant0124)
+		t.Run(fmt.Sprintf("WriteAll: %d", count), func(t *testing.T) {
+			ctrlLen := (count + 3) / 4
+			actual := make([]byte, ctrlLen, MaxEncodedLen(count))
+			for i := 0; i < count; i += 4 {
+				var data [16]byte
+				ctrl := encode.PutUint32Scalar0124(nums[i:], data[:], count-i)
+				actual[i/4] = ctrl
+				actual = append(actual, data[:shared.ControlByteToSize0124(ctrl)]...)
+			}
+
+			if !reflect.DeepEqual(stream, actual) {
+				t.Fatalf("bad encoding")
+			}
+		})
+	}
+}
+
+func TestWriteAllDeltaVariant(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		diffed := make([]uint32, count)
+		util.Delta(nums, diffed)
+
+		stream := WriteAllVariant(diffed, shared.Variant0124)
+		t.Run(fmt.Sprintf("WriteAll: %d", count), func(t *testing.T) {
+			actual := WriteAllDeltaVariant(nums, 0, shared.Variant0124)
+			if !reflect.DeepEqual(stream, actual) {
+				t.Fatalf("bad encoding")
+			}
+		})
+	}
+}
+
+func TestWriteAllParallel(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e6)
+		nums := util.GenUint32(count)
+		prev := util.RandUint32()
+		workers := rand.Intn(8)
+		t.Run(fmt.Sprintf("WriteAllParallel: %d, %d workers", count, workers), func(t *testing.T) {
+			if !reflect.DeepEqual(WriteAll(nums), WriteAllParallel(nums, workers)) {
+				t.Fatalf("bad encoding")
+			}
+			if !reflect.DeepEqual(WriteAllDelta(nums, prev), WriteAllDeltaParallel(nums, prev, workers)) {
+				t.Fatalf("bad delta encoding")
+			}
+		})
+	}
+}
+
+func TestEncodedLen(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		expected := len(WriteAllScalar(nums))
+		t.Run(fmt.Sprintf("EncodedLen: %d", count), func(t *testing.T) {
+			if actual := EncodedLenScalar(nums); actual != expected {
+				t.Fatalf("expected %d, got %d", expected, actual)
+			}
+
+			if encode.GetMode() == shared.Normal {
+				return
+			}
+
+			if actual := EncodedLenFast(nums); actual != expected {
+				t.Fatalf("expected %d, got %d", expected, actual)
+			}
+		})
+	}
+}
+
+func TestEncodedLenDelta(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		expected := len(WriteAllDeltaScalar(nums, 0))
+		t.Run(fmt.Sprintf("EncodedLen: %d", count), func(t *testing.T) {
+			if actual := EncodedLenDeltaScalar(nums, 0); actual != expected {
+				t.Fatalf("expected %d, got %d", expected, actual)
+			}
+
+			if encode.GetMode() == shared.Normal {
+				return
+			}
+
+			if actual := EncodedLenDeltaFast(nums, 0); actual != expected {
+				t.Fatalf("expected %d, got %d", expected, actual)
+			}
+		})
+	}
+}
+
+func TestAppendAll(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32()%1e5) + 1
+		nums := util.GenUint32(count)
+		prefix := util.GenUint32(rand.Intn(16))
+		dst := WriteAllScalar(prefix)
+		expected := append(append([]byte{}, dst...), WriteAllScalar(nums)...)
+		t.Run(fmt.Sprintf("AppendAll: %d", count), func(t *testing.T) {
+			actual := AppendAll(dst, nums)
+			if !reflect.DeepEqual(expected, actual) {
+				t.Fatalf("bad encoding")
+			}
+
+			reused := AppendAll(actual[:0], nums)
+			if &reused[0] != &actual[0] {
+				t.Fatalf("expected buffer to be reused")
+			}
+		})
+	}
+}
+
+func TestAppendAllDelta(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		prefix := util.GenUint32(rand.Intn(16))
+		dst := WriteAllScalar(prefix)
+		expected := append(append([]byte{}, dst...), WriteAllDeltaScalar(nums, 0)...)
+		t.Run(fmt.Sprintf("AppendAll: %d", count), func(t *testing.T) {
+			actual := AppendAllDelta(dst, nums, 0)
+			if !reflect.DeepEqual(expected, actual) {
+				t.Fatalf("bad encoding")
+			}
+		})
+	}
+}
+
+func TestWriteAllSplit(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		prev := util.RandUint32()
+		t.Run(fmt.Sprintf("WriteAllSplit: %d", count), func(t *testing.T) {
+			ctrl, data := WriteAllSplit(nums)
+			if !reflect.DeepEqual(WriteAllScalar(nums), append(ctrl, data...)) {
+				t.Fatalf("bad encoding")
+			}
+
+			ctrl, data = WriteAllDeltaSplit(nums, prev)
+			if !reflect.DeepEqual(WriteAllDeltaScalar(nums, prev), append(ctrl, data...)) {
+				t.Fatalf("bad delta encoding")
+			}
+		})
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		opts := EncoderOptions{
+			BlockLen: rand.Intn(1024),
+			Delta:    i%2 == 0,
+		}
+		t.Run(fmt.Sprintf("Encoder: %d", count), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			enc := NewEncoder(buf, opts)
+			for written := 0; written < count; {
+				n := rand.Intn(count-written) + 1
+				if err := enc.Write(nums[written : written+n]); err != nil {
+					t.Fatalf("failed to write: %v", err)
+				}
+				written += n
+			}
+			if err := enc.Close(); err != nil {
+				t.Fatalf("failed to close: %v", err)
+			}
+
+			flags, _ := buf.ReadByte()
+			if delta := flags&shared.FrameFlagDelta != 0; delta != opts.Delta {
+				t.Fatalf("expected delta flag %v", opts.Delta)
+			}
+
+			prev, _ := binary.ReadUvarint(buf)
+			var actual []uint32
+			for buf.Len() > 0 {
+				blockCount, _ := binary.ReadUvarint(buf)
+				blockLen, _ := binary.ReadUvarint(buf)
+				block := buf.Next(int(blockLen))
+				out := make([]uint32, blockCount)
+				if opts.Delta {
+					reader.ReadAllDelta(int(blockCount), block, out, uint32(prev))
+					prev = uint64(out[blockCount-1])
+				} else {
+					reader.ReadAll(int(blockCount), block, out)
+				}
+				actual = append(actual, out...)
+			}
+
+			if len(actual) != count || (count > 0 && !reflect.DeepEqual(nums, actual)) {
+				t.Fatalf("decoded wrong nums")
+			}
+		})
+	}
+}
+
 var readSinkA []byte
 
 func BenchmarkWriteAllFast(b *testing.B) {
@@ -214,3 +612,54 @@ func BenchmarkWriteAllDeltaVarint(b *testing.B) {
 		})
 	}
 }
+
+func TestTrailerWriter(t *testing.T) {
+	for i := 0; i < 6; i++ {
+		count := int(util.RandUint32() % 1e5)
+		nums := util.GenUint32(count)
+		util.SortUint32(nums)
+		opts := EncoderOptions{
+			BlockLen: rand.Intn(1024),
+			Delta:    i%2 == 0,
+			Prev:     util.RandUint32(),
+		}
+		t.Run(fmt.Sprintf("TrailerWriter: %d", count), func(t *testing.T) {
+			expected := WriteAllTrailer(nums)
+			scalar := WriteAllScalar(nums)
+			if opts.Delta {
+				expected = WriteAllDeltaTrailer(nums, opts.Prev)
+				scalar = WriteAllDeltaScalar(nums, opts.Prev)
+			}
+			ctrlLen := (count + 3) / 4
+			body := append(append([]byte{}, scalar[ctrlLen:]...), scalar[:ctrlLen]...)
+			if !reflect.DeepEqual(body, expected[:len(expected)-shared.TrailerFooterLen]) {
+				t.Fatalf("expected the data bytes followed by the control bytes")
+			}
+
+			buf := &bytes.Buffer{}
+			tw := NewTrailerWriter(buf, opts)
+			for written := 0; written < count; {
+				n := rand.Intn(count-written) + 1
+				if err := tw.Write(nums[written : written+n]); err != nil {
+					t.Fatalf("failed to write: %v", err)
+				}
+				if rand.Intn(4) == 0 {
+					if err := tw.Flush(); err != nil {
+						t.Fatalf("failed to flush: %v", err)
+					}
+				}
+				written += n
+			}
+			if err := tw.Close(); err != nil {
+				t.Fatalf("failed to close: %v", err)
+			}
+
+			if !reflect.DeepEqual(expected, buf.Bytes()) {
+				t.Fatalf("bad encoding")
+			}
+			if err := tw.Write(nums); err != ErrClosed {
+				t.Fatalf("expected %v, got %v", ErrClosed, err)
+			}
+		})
+	}
+}
@@ -46,3 +46,18 @@ var (
 func RandUint32() uint32 {
 	return generators[rand.Int()%4]()
 }
+
+// RandUint64 generates a random number that is uniformly random on the
+// axis for the number of bytes required to encode it using the 64-bit
+// variant of Stream VByte, i.e. 1, 2, 4 or 8.
+func RandUint64() uint64 {
+	switch rand.Int() % 4 {
+	case 0:
+		return uint64(rand.Intn(1 << 8))
+	case 1:
+		return uint64(randUint32Range(1<<8, 1<<16-1))
+	case 2:
+		return uint64(randUint32Range(1<<16, math.MaxUint32))
+	}
+	return rand.Uint64()>>1 + 1<<32
+}
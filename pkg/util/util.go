@@ -2,6 +2,7 @@ package util
 
 import (
 	"io"
+	"math/rand"
 	"sort"
 )
 
@@ -18,12 +19,55 @@ func GenUint32(n int) []uint32 {
 	return nums
 }
 
+func GenInt32(n int) []int32 {
+	nums := make([]int32, n)
+	for i := 0; i < n; i++ {
+		num := int32(RandUint32() >> 1)
+		if rand.Intn(2) == 0 {
+			num = -num
+		}
+		nums[i] = num
+	}
+
+	return nums
+}
+
+func GenUint64(n int) []uint64 {
+	nums := make([]uint64, n)
+	for i := 0; i < n; i++ {
+		nums[i] = RandUint64()
+	}
+
+	return nums
+}
+
+func GenUint16(n int) []uint16 {
+	nums := make([]uint16, n)
+	for i := 0; i < n; i++ {
+		nums[i] = uint16(RandUint32() >> (rand.Intn(2) * 8))
+	}
+
+	return nums
+}
+
 func SortUint32(in []uint32) {
 	sort.Slice(in, func(i, j int) bool {
 		return in[i] < in[j]
 	})
 }
 
+func SortUint64(in []uint64) {
+	sort.Slice(in, func(i, j int) bool {
+		return in[i] < in[j]
+	})
+}
+
+func SortUint16(in []uint16) {
+	sort.Slice(in, func(i, j int) bool {
+		return in[i] < in[j]
+	})
+}
+
 func Delta(in []uint32, out []uint32) {
 	for i := range in {
 		if i > 0 {